@@ -0,0 +1,44 @@
+package sqlserver
+
+import (
+	"net/url"
+
+	"github.com/goravel/sqlserver/contracts"
+)
+
+// authParams returns the username, password and the extra DSN parameters of the authentication method,
+// the parameters are consumed by the azuread connector of go-mssqldb.
+func authParams(fullConfig contracts.FullConfig) (string, string, url.Values) {
+	auth := fullConfig.Auth
+	params := url.Values{}
+	if auth.Method == "" {
+		return fullConfig.Username, fullConfig.Password, params
+	}
+
+	username, password := fullConfig.Username, fullConfig.Password
+	switch auth.Method {
+	case contracts.AuthActiveDirectoryDefault:
+		username, password = "", ""
+	case contracts.AuthActiveDirectoryPassword:
+		params.Set("applicationclientid", auth.ClientID)
+	case contracts.AuthActiveDirectoryServicePrincipal:
+		username = auth.ClientID
+		if auth.TenantID != "" {
+			username += "@" + auth.TenantID
+		}
+		password = auth.ClientSecret
+		if auth.CertificatePath != "" {
+			params.Set("clientcertpath", auth.CertificatePath)
+			password = auth.CertificatePassword
+		}
+	case contracts.AuthActiveDirectoryManagedIdentity:
+		username, password = auth.ClientID, ""
+		if auth.ResourceID != "" {
+			params.Set("resource id", auth.ResourceID)
+		}
+	}
+
+	params.Set("fedauth", auth.Method)
+
+	return username, password, params
+}
@@ -0,0 +1,80 @@
+package sqlserver
+
+import (
+	"strings"
+
+	"github.com/goravel/framework/contracts/database/driver"
+	contractsschema "github.com/goravel/framework/contracts/database/schema"
+)
+
+// Collation Set the collation of the column, a UTF-8 collation such as Latin1_General_100_CI_AS_SC_UTF8 turns
+// nchar and nvarchar into char and varchar.
+//
+//	sqlserver.Collation(table.String("name"), "Latin1_General_100_CI_AS_SC_UTF8")
+func Collation(column driver.ColumnDefinition, collation string) driver.ColumnDefinition {
+	setColumnOptions(column, func(extra *extraOptions) {
+		extra.collation = collation
+	})
+
+	return column
+}
+
+// TableCollation Set the default collation of the string columns of the table, SQL Server has no table collation,
+// so it's applied to every string column that has no collation of its own.
+//
+//	sqlserver.TableCollation(table, "Latin1_General_100_CI_AS_SC_UTF8")
+func TableCollation(blueprint contractsschema.Blueprint, collation string) {
+	setTableOptions(blueprint, func(extra *extraOptions) {
+		extra.collation = collation
+	})
+}
+
+// Identity Make the integer column an identity column that starts at the seed and steps by the increment, the column
+// doesn't need AutoIncrement.
+//
+//	sqlserver.Identity(table.ID(), 1000, 10)
+func Identity(column driver.ColumnDefinition, seed, increment int64) driver.ColumnDefinition {
+	setColumnOptions(column, func(extra *extraOptions) {
+		extra.identity = &identity{seed: seed, increment: increment}
+	})
+
+	return column
+}
+
+// StoredAs Make the column a persisted computed column, SQL Server stores the value of the expression and updates it when
+// the columns in it change. The type of the column is the type of the expression.
+//
+//	sqlserver.StoredAs(table.Decimal("total"), "[price] * [quantity]")
+func StoredAs(column driver.ColumnDefinition, expression string) driver.ColumnDefinition {
+	setColumnOptions(column, func(extra *extraOptions) {
+		extra.computed = &computed{expression: expression, persisted: true}
+	})
+
+	return column
+}
+
+// VirtualAs Make the column a computed column, SQL Server computes the value of the expression when the column is read.
+// The type of the column is the type of the expression, and it's always nullable.
+//
+//	sqlserver.VirtualAs(table.String("full_name"), "[first_name] + ' ' + [last_name]")
+func VirtualAs(column driver.ColumnDefinition, expression string) driver.ColumnDefinition {
+	setColumnOptions(column, func(extra *extraOptions) {
+		extra.computed = &computed{expression: expression}
+	})
+
+	return column
+}
+
+type identity struct {
+	seed      int64
+	increment int64
+}
+
+type computed struct {
+	expression string
+	persisted  bool
+}
+
+func isUTF8Collation(collation string) bool {
+	return strings.HasSuffix(strings.ToUpper(collation), "_UTF8")
+}
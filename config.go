@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/goravel/framework/contracts/config"
+	"github.com/spf13/cast"
 
 	"github.com/goravel/sqlserver/contracts"
 )
@@ -31,7 +32,14 @@ func (r *Config) Connection() string {
 func (r *Config) Readers() []contracts.FullConfig {
 	configs := r.config.Get(fmt.Sprintf("database.connections.%s.read", r.connection))
 	if readConfigs, ok := configs.([]contracts.Config); ok {
-		return r.fillDefault(readConfigs)
+		fullConfigs := r.fillDefault(readConfigs)
+		for i := range fullConfigs {
+			if fullConfigs[i].ApplicationIntent == "" {
+				fullConfigs[i].ApplicationIntent = contracts.ApplicationIntentReadOnly
+			}
+		}
+
+		return fullConfigs
 	}
 
 	return nil
@@ -55,12 +63,16 @@ func (r *Config) fillDefault(configs []contracts.Config) []contracts.FullConfig
 	var fullConfigs []contracts.FullConfig
 	for _, config := range configs {
 		fullConfig := contracts.FullConfig{
-			Config:      config,
-			Connection:  r.connection,
-			Driver:      Name,
-			NoLowerCase: r.config.GetBool(fmt.Sprintf("database.connections.%s.no_lower_case", r.connection)),
-			Prefix:      r.config.GetString(fmt.Sprintf("database.connections.%s.prefix", r.connection)),
-			Singular:    r.config.GetBool(fmt.Sprintf("database.connections.%s.singular", r.connection)),
+			Config:              config,
+			Collation:           r.config.GetString(fmt.Sprintf("database.connections.%s.collation", r.connection)),
+			Connection:          r.connection,
+			Driver:              Name,
+			FullTextCatalog:     r.config.GetString(fmt.Sprintf("database.connections.%s.full_text_catalog", r.connection)),
+			NoLowerCase:         r.config.GetBool(fmt.Sprintf("database.connections.%s.no_lower_case", r.connection)),
+			Prefix:              r.config.GetString(fmt.Sprintf("database.connections.%s.prefix", r.connection)),
+			Schema:              r.config.GetString(fmt.Sprintf("database.connections.%s.schema", r.connection)),
+			Singular:            r.config.GetBool(fmt.Sprintf("database.connections.%s.singular", r.connection)),
+			UniqueNullsDistinct: r.config.GetBool(fmt.Sprintf("database.connections.%s.unique_nulls_distinct", r.connection)),
 		}
 		if nameReplacer := r.config.Get(fmt.Sprintf("database.connections.%s.name_replacer", r.connection)); nameReplacer != nil {
 			if replacer, ok := nameReplacer.(contracts.Replacer); ok {
@@ -78,12 +90,64 @@ func (r *Config) fillDefault(configs []contracts.Config) []contracts.FullConfig
 		if fullConfig.Port == 0 {
 			fullConfig.Port = r.config.GetInt(fmt.Sprintf("database.connections.%s.port", r.connection))
 		}
+		if fullConfig.Instance == "" {
+			fullConfig.Instance = r.config.GetString(fmt.Sprintf("database.connections.%s.instance", r.connection))
+		}
 		if fullConfig.Username == "" {
 			fullConfig.Username = r.config.GetString(fmt.Sprintf("database.connections.%s.username", r.connection))
 		}
 		if fullConfig.Password == "" {
 			fullConfig.Password = r.config.GetString(fmt.Sprintf("database.connections.%s.password", r.connection))
 		}
+		if !fullConfig.MultiSubnetFailover {
+			fullConfig.MultiSubnetFailover = r.config.GetBool(fmt.Sprintf("database.connections.%s.multi_subnet_failover", r.connection))
+		}
+		if fullConfig.AppName == "" {
+			fullConfig.AppName = r.config.GetString(fmt.Sprintf("database.connections.%s.app_name", r.connection))
+		}
+		if fullConfig.ConnectionTimeout == 0 {
+			fullConfig.ConnectionTimeout = r.config.GetInt(fmt.Sprintf("database.connections.%s.connection_timeout", r.connection))
+		}
+		if fullConfig.DialTimeout == 0 {
+			fullConfig.DialTimeout = r.config.GetInt(fmt.Sprintf("database.connections.%s.dial_timeout", r.connection))
+		}
+		if fullConfig.KeepAlive == 0 {
+			fullConfig.KeepAlive = r.config.GetInt(fmt.Sprintf("database.connections.%s.keep_alive", r.connection))
+		}
+		if fullConfig.PacketSize == 0 {
+			fullConfig.PacketSize = r.config.GetInt(fmt.Sprintf("database.connections.%s.packet_size", r.connection))
+		}
+		if fullConfig.FailoverPartner == "" {
+			fullConfig.FailoverPartner = r.config.GetString(fmt.Sprintf("database.connections.%s.failover_partner", r.connection))
+		}
+		if fullConfig.FailoverPort == 0 {
+			fullConfig.FailoverPort = r.config.GetInt(fmt.Sprintf("database.connections.%s.failover_port", r.connection))
+		}
+		if fullConfig.Auth.Method == "" {
+			if auth, ok := r.config.Get(fmt.Sprintf("database.connections.%s.auth", r.connection)).(contracts.Auth); ok {
+				fullConfig.Auth = auth
+			}
+		}
+		if fullConfig.TLS == (contracts.TLS{}) {
+			if tls, ok := r.config.Get(fmt.Sprintf("database.connections.%s.tls", r.connection)).(contracts.TLS); ok {
+				fullConfig.TLS = tls
+			}
+		}
+		if fullConfig.Retry.MaxAttempts == 0 {
+			if retry, ok := r.config.Get(fmt.Sprintf("database.connections.%s.retry", r.connection)).(contracts.Retry); ok {
+				fullConfig.Retry = retry
+			}
+		}
+		if isZeroSession(fullConfig.Session) {
+			if session, ok := r.config.Get(fmt.Sprintf("database.connections.%s.session", r.connection)).(contracts.Session); ok {
+				fullConfig.Session = session
+			}
+		}
+		if fullConfig.Options == nil {
+			if options := cast.ToStringMapString(r.config.Get(fmt.Sprintf("database.connections.%s.options", r.connection))); len(options) > 0 {
+				fullConfig.Options = options
+			}
+		}
 		if fullConfig.Database == "" {
 			fullConfig.Database = r.config.GetString(fmt.Sprintf("database.connections.%s.database", r.connection))
 		}
@@ -47,9 +47,27 @@ func (s *ConfigTestSuite) TestReads() {
 		},
 	}).Once()
 	s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.prefix", s.connection)).Return("goravel_").Once()
+	s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.collation", s.connection)).Return("").Once()
+	s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.full_text_catalog", s.connection)).Return("").Once()
+	s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.schema", s.connection)).Return("").Once()
 	s.mockConfig.EXPECT().GetBool(fmt.Sprintf("database.connections.%s.singular", s.connection)).Return(false).Once()
 	s.mockConfig.EXPECT().GetBool(fmt.Sprintf("database.connections.%s.no_lower_case", s.connection)).Return(false).Once()
+	s.mockConfig.EXPECT().GetBool(fmt.Sprintf("database.connections.%s.unique_nulls_distinct", s.connection)).Return(false).Once()
 	s.mockConfig.EXPECT().Get(fmt.Sprintf("database.connections.%s.name_replacer", s.connection)).Return(nil).Once()
+	s.mockConfig.EXPECT().Get(fmt.Sprintf("database.connections.%s.auth", s.connection)).Return(nil).Once()
+	s.mockConfig.EXPECT().Get(fmt.Sprintf("database.connections.%s.options", s.connection)).Return(nil).Once()
+	s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.instance", s.connection)).Return("").Once()
+	s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.app_name", s.connection)).Return("").Once()
+	s.mockConfig.EXPECT().GetInt(fmt.Sprintf("database.connections.%s.connection_timeout", s.connection)).Return(0).Once()
+	s.mockConfig.EXPECT().GetInt(fmt.Sprintf("database.connections.%s.dial_timeout", s.connection)).Return(0).Once()
+	s.mockConfig.EXPECT().GetInt(fmt.Sprintf("database.connections.%s.keep_alive", s.connection)).Return(0).Once()
+	s.mockConfig.EXPECT().GetInt(fmt.Sprintf("database.connections.%s.packet_size", s.connection)).Return(0).Once()
+	s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.failover_partner", s.connection)).Return("").Once()
+	s.mockConfig.EXPECT().GetInt(fmt.Sprintf("database.connections.%s.failover_port", s.connection)).Return(0).Once()
+	s.mockConfig.EXPECT().GetBool(fmt.Sprintf("database.connections.%s.multi_subnet_failover", s.connection)).Return(false).Once()
+	s.mockConfig.EXPECT().Get(fmt.Sprintf("database.connections.%s.tls", s.connection)).Return(nil).Once()
+	s.mockConfig.EXPECT().Get(fmt.Sprintf("database.connections.%s.retry", s.connection)).Return(nil).Once()
+	s.mockConfig.EXPECT().Get(fmt.Sprintf("database.connections.%s.session", s.connection)).Return(nil).Once()
 	s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.charset", s.connection)).Return("utf8mb4").Once()
 	s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.timezone", s.connection)).Return("UTC").Once()
 	s.Equal([]contracts.FullConfig{
@@ -62,29 +80,86 @@ func (s *ConfigTestSuite) TestReads() {
 			NoLowerCase:  false,
 			NameReplacer: nil,
 			Config: contracts.Config{
-				Dsn:      "dsn",
-				Database: "forge",
-				Host:     "localhost",
-				Port:     3306,
-				Username: "root",
-				Password: "123123",
+				Dsn:               "dsn",
+				Database:          "forge",
+				Host:              "localhost",
+				Port:              3306,
+				Username:          "root",
+				Password:          "123123",
+				ApplicationIntent: contracts.ApplicationIntentReadOnly,
 			},
 			Timezone: "UTC",
 		},
 	}, s.config.Readers())
+
+	// Test when the application intent is overridden
+	s.mockConfig.EXPECT().Get(fmt.Sprintf("database.connections.%s.read", s.connection)).Return([]contracts.Config{
+		{
+			Dsn:                 "dsn",
+			Database:            "forge",
+			Host:                "localhost",
+			Port:                3306,
+			Username:            "root",
+			Password:            "123123",
+			MultiSubnetFailover: true,
+			ApplicationIntent:   contracts.ApplicationIntentReadWrite,
+		},
+	}).Once()
+	s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.prefix", s.connection)).Return("goravel_").Once()
+	s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.collation", s.connection)).Return("").Once()
+	s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.full_text_catalog", s.connection)).Return("").Once()
+	s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.schema", s.connection)).Return("").Once()
+	s.mockConfig.EXPECT().GetBool(fmt.Sprintf("database.connections.%s.singular", s.connection)).Return(false).Once()
+	s.mockConfig.EXPECT().GetBool(fmt.Sprintf("database.connections.%s.no_lower_case", s.connection)).Return(false).Once()
+	s.mockConfig.EXPECT().GetBool(fmt.Sprintf("database.connections.%s.unique_nulls_distinct", s.connection)).Return(false).Once()
+	s.mockConfig.EXPECT().Get(fmt.Sprintf("database.connections.%s.name_replacer", s.connection)).Return(nil).Once()
+	s.mockConfig.EXPECT().Get(fmt.Sprintf("database.connections.%s.auth", s.connection)).Return(nil).Once()
+	s.mockConfig.EXPECT().Get(fmt.Sprintf("database.connections.%s.options", s.connection)).Return(nil).Once()
+	s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.instance", s.connection)).Return("").Once()
+	s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.app_name", s.connection)).Return("").Once()
+	s.mockConfig.EXPECT().GetInt(fmt.Sprintf("database.connections.%s.connection_timeout", s.connection)).Return(0).Once()
+	s.mockConfig.EXPECT().GetInt(fmt.Sprintf("database.connections.%s.dial_timeout", s.connection)).Return(0).Once()
+	s.mockConfig.EXPECT().GetInt(fmt.Sprintf("database.connections.%s.keep_alive", s.connection)).Return(0).Once()
+	s.mockConfig.EXPECT().GetInt(fmt.Sprintf("database.connections.%s.packet_size", s.connection)).Return(0).Once()
+	s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.failover_partner", s.connection)).Return("").Once()
+	s.mockConfig.EXPECT().GetInt(fmt.Sprintf("database.connections.%s.failover_port", s.connection)).Return(0).Once()
+	s.mockConfig.EXPECT().Get(fmt.Sprintf("database.connections.%s.tls", s.connection)).Return(nil).Once()
+	s.mockConfig.EXPECT().Get(fmt.Sprintf("database.connections.%s.retry", s.connection)).Return(nil).Once()
+	s.mockConfig.EXPECT().Get(fmt.Sprintf("database.connections.%s.session", s.connection)).Return(nil).Once()
+	s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.charset", s.connection)).Return("utf8mb4").Once()
+	s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.timezone", s.connection)).Return("UTC").Once()
+	s.Equal(contracts.ApplicationIntentReadWrite, s.config.Readers()[0].ApplicationIntent)
 }
 
 func (s *ConfigTestSuite) TestWrites() {
 	s.Run("success when configs is empty", func() {
 		s.mockConfig.EXPECT().Get(fmt.Sprintf("database.connections.%s.write", s.connection)).Return(nil).Once()
 		s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.prefix", s.connection)).Return("goravel_").Once()
+		s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.collation", s.connection)).Return("").Once()
+		s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.full_text_catalog", s.connection)).Return("").Once()
+		s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.schema", s.connection)).Return("").Once()
 		s.mockConfig.EXPECT().GetBool(fmt.Sprintf("database.connections.%s.singular", s.connection)).Return(false).Once()
 		s.mockConfig.EXPECT().GetBool(fmt.Sprintf("database.connections.%s.no_lower_case", s.connection)).Return(false).Once()
+		s.mockConfig.EXPECT().GetBool(fmt.Sprintf("database.connections.%s.unique_nulls_distinct", s.connection)).Return(false).Once()
 		s.mockConfig.EXPECT().Get(fmt.Sprintf("database.connections.%s.name_replacer", s.connection)).Return(nil).Once()
+		s.mockConfig.EXPECT().Get(fmt.Sprintf("database.connections.%s.auth", s.connection)).Return(nil).Once()
+		s.mockConfig.EXPECT().Get(fmt.Sprintf("database.connections.%s.options", s.connection)).Return(nil).Once()
+		s.mockConfig.EXPECT().Get(fmt.Sprintf("database.connections.%s.tls", s.connection)).Return(nil).Once()
+		s.mockConfig.EXPECT().Get(fmt.Sprintf("database.connections.%s.retry", s.connection)).Return(nil).Once()
+		s.mockConfig.EXPECT().Get(fmt.Sprintf("database.connections.%s.session", s.connection)).Return(nil).Once()
 		s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.charset", s.connection)).Return("utf8mb4").Once()
 		s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.dsn", s.connection)).Return("dsn").Once()
 		s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.host", s.connection)).Return("localhost").Once()
 		s.mockConfig.EXPECT().GetInt(fmt.Sprintf("database.connections.%s.port", s.connection)).Return(3306).Once()
+		s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.instance", s.connection)).Return("").Once()
+		s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.app_name", s.connection)).Return("").Once()
+		s.mockConfig.EXPECT().GetInt(fmt.Sprintf("database.connections.%s.connection_timeout", s.connection)).Return(0).Once()
+		s.mockConfig.EXPECT().GetInt(fmt.Sprintf("database.connections.%s.dial_timeout", s.connection)).Return(0).Once()
+		s.mockConfig.EXPECT().GetInt(fmt.Sprintf("database.connections.%s.keep_alive", s.connection)).Return(0).Once()
+		s.mockConfig.EXPECT().GetInt(fmt.Sprintf("database.connections.%s.packet_size", s.connection)).Return(0).Once()
+		s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.failover_partner", s.connection)).Return("").Once()
+		s.mockConfig.EXPECT().GetInt(fmt.Sprintf("database.connections.%s.failover_port", s.connection)).Return(0).Once()
+		s.mockConfig.EXPECT().GetBool(fmt.Sprintf("database.connections.%s.multi_subnet_failover", s.connection)).Return(false).Once()
 		s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.username", s.connection)).Return("root").Once()
 		s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.password", s.connection)).Return("123123").Once()
 		s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.database", s.connection)).Return("forge").Once()
@@ -119,13 +194,31 @@ func (s *ConfigTestSuite) TestWrites() {
 			},
 		}).Once()
 		s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.prefix", s.connection)).Return("goravel_").Once()
+		s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.collation", s.connection)).Return("").Once()
+		s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.full_text_catalog", s.connection)).Return("").Once()
+		s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.schema", s.connection)).Return("").Once()
 		s.mockConfig.EXPECT().GetBool(fmt.Sprintf("database.connections.%s.singular", s.connection)).Return(false).Once()
 		s.mockConfig.EXPECT().GetBool(fmt.Sprintf("database.connections.%s.no_lower_case", s.connection)).Return(false).Once()
+		s.mockConfig.EXPECT().GetBool(fmt.Sprintf("database.connections.%s.unique_nulls_distinct", s.connection)).Return(false).Once()
 		s.mockConfig.EXPECT().Get(fmt.Sprintf("database.connections.%s.name_replacer", s.connection)).Return(nil).Once()
+		s.mockConfig.EXPECT().Get(fmt.Sprintf("database.connections.%s.auth", s.connection)).Return(nil).Once()
+		s.mockConfig.EXPECT().Get(fmt.Sprintf("database.connections.%s.options", s.connection)).Return(nil).Once()
+		s.mockConfig.EXPECT().Get(fmt.Sprintf("database.connections.%s.tls", s.connection)).Return(nil).Once()
+		s.mockConfig.EXPECT().Get(fmt.Sprintf("database.connections.%s.retry", s.connection)).Return(nil).Once()
+		s.mockConfig.EXPECT().Get(fmt.Sprintf("database.connections.%s.session", s.connection)).Return(nil).Once()
 		s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.charset", s.connection)).Return("utf8mb4").Once()
 		s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.dsn", s.connection)).Return("dsn").Once()
 		s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.host", s.connection)).Return("localhost").Once()
 		s.mockConfig.EXPECT().GetInt(fmt.Sprintf("database.connections.%s.port", s.connection)).Return(3306).Once()
+		s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.instance", s.connection)).Return("").Once()
+		s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.app_name", s.connection)).Return("").Once()
+		s.mockConfig.EXPECT().GetInt(fmt.Sprintf("database.connections.%s.connection_timeout", s.connection)).Return(0).Once()
+		s.mockConfig.EXPECT().GetInt(fmt.Sprintf("database.connections.%s.dial_timeout", s.connection)).Return(0).Once()
+		s.mockConfig.EXPECT().GetInt(fmt.Sprintf("database.connections.%s.keep_alive", s.connection)).Return(0).Once()
+		s.mockConfig.EXPECT().GetInt(fmt.Sprintf("database.connections.%s.packet_size", s.connection)).Return(0).Once()
+		s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.failover_partner", s.connection)).Return("").Once()
+		s.mockConfig.EXPECT().GetInt(fmt.Sprintf("database.connections.%s.failover_port", s.connection)).Return(0).Once()
+		s.mockConfig.EXPECT().GetBool(fmt.Sprintf("database.connections.%s.multi_subnet_failover", s.connection)).Return(false).Once()
 		s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.username", s.connection)).Return("root").Once()
 		s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.password", s.connection)).Return("123123").Once()
 		s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.timezone", s.connection)).Return("").Once()
@@ -158,14 +251,42 @@ func (s *ConfigTestSuite) TestFillDefault() {
 	dsn := "dsn"
 	host := "localhost"
 	port := 3306
+	instance := "SQLEXPRESS"
+	appName := "goravel"
+	connectionTimeout := 30
+	dialTimeout := 15
+	keepAlive := 30
+	packetSize := 4096
+	failoverPartner := "mirror"
+	failoverPort := 1433
 	database := "forge"
 	username := "root"
 	password := "123123"
 	prefix := "goravel_"
+	collation := "Latin1_General_100_CI_AS_SC_UTF8"
+	fullTextCatalog := "goravel"
+	schema := "app"
 	singular := false
 	charset := "utf8mb4"
 	timezone := "UTC"
 	nameReplacer := strings.NewReplacer("a", "b")
+	auth := contracts.Auth{
+		Method:   contracts.AuthActiveDirectoryManagedIdentity,
+		ClientID: "client",
+	}
+	tls := contracts.TLS{
+		Encrypt:     contracts.EncryptStrict,
+		Certificate: "ca.pem",
+	}
+	retry := contracts.Retry{
+		MaxAttempts: 3,
+	}
+	session := contracts.Session{
+		XactAbort: true,
+	}
+	options := map[string]any{
+		"app name": "goravel",
+	}
 
 	tests := []struct {
 		name          string
@@ -183,12 +304,30 @@ func (s *ConfigTestSuite) TestFillDefault() {
 			configs: []contracts.Config{{}},
 			setup: func() {
 				s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.prefix", s.connection)).Return(prefix).Once()
+				s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.collation", s.connection)).Return(collation).Once()
+				s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.full_text_catalog", s.connection)).Return(fullTextCatalog).Once()
+				s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.schema", s.connection)).Return(schema).Once()
 				s.mockConfig.EXPECT().GetBool(fmt.Sprintf("database.connections.%s.singular", s.connection)).Return(singular).Once()
 				s.mockConfig.EXPECT().GetBool(fmt.Sprintf("database.connections.%s.no_lower_case", s.connection)).Return(true).Once()
+				s.mockConfig.EXPECT().GetBool(fmt.Sprintf("database.connections.%s.unique_nulls_distinct", s.connection)).Return(true).Once()
 				s.mockConfig.EXPECT().Get(fmt.Sprintf("database.connections.%s.name_replacer", s.connection)).Return(nameReplacer).Once()
+				s.mockConfig.EXPECT().Get(fmt.Sprintf("database.connections.%s.auth", s.connection)).Return(auth).Once()
+				s.mockConfig.EXPECT().Get(fmt.Sprintf("database.connections.%s.options", s.connection)).Return(options).Once()
+				s.mockConfig.EXPECT().Get(fmt.Sprintf("database.connections.%s.tls", s.connection)).Return(tls).Once()
+				s.mockConfig.EXPECT().Get(fmt.Sprintf("database.connections.%s.retry", s.connection)).Return(retry).Once()
+				s.mockConfig.EXPECT().Get(fmt.Sprintf("database.connections.%s.session", s.connection)).Return(session).Once()
 				s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.dsn", s.connection)).Return(dsn).Once()
 				s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.host", s.connection)).Return(host).Once()
 				s.mockConfig.EXPECT().GetInt(fmt.Sprintf("database.connections.%s.port", s.connection)).Return(port).Once()
+				s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.instance", s.connection)).Return(instance).Once()
+				s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.app_name", s.connection)).Return(appName).Once()
+				s.mockConfig.EXPECT().GetInt(fmt.Sprintf("database.connections.%s.connection_timeout", s.connection)).Return(connectionTimeout).Once()
+				s.mockConfig.EXPECT().GetInt(fmt.Sprintf("database.connections.%s.dial_timeout", s.connection)).Return(dialTimeout).Once()
+				s.mockConfig.EXPECT().GetInt(fmt.Sprintf("database.connections.%s.keep_alive", s.connection)).Return(keepAlive).Once()
+				s.mockConfig.EXPECT().GetInt(fmt.Sprintf("database.connections.%s.packet_size", s.connection)).Return(packetSize).Once()
+				s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.failover_partner", s.connection)).Return(failoverPartner).Once()
+				s.mockConfig.EXPECT().GetInt(fmt.Sprintf("database.connections.%s.failover_port", s.connection)).Return(failoverPort).Once()
+				s.mockConfig.EXPECT().GetBool(fmt.Sprintf("database.connections.%s.multi_subnet_failover", s.connection)).Return(true).Once()
 				s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.database", s.connection)).Return(database).Once()
 				s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.username", s.connection)).Return(username).Once()
 				s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.password", s.connection)).Return(password).Once()
@@ -197,20 +336,40 @@ func (s *ConfigTestSuite) TestFillDefault() {
 			},
 			expectConfigs: []contracts.FullConfig{
 				{
-					Connection:   s.connection,
-					Driver:       Name,
-					Prefix:       prefix,
-					Singular:     singular,
-					Charset:      charset,
-					NoLowerCase:  true,
-					NameReplacer: nameReplacer,
+					Connection:          s.connection,
+					Driver:              Name,
+					Prefix:              prefix,
+					Collation:           collation,
+					FullTextCatalog:     fullTextCatalog,
+					Schema:              schema,
+					Singular:            singular,
+					Charset:             charset,
+					NoLowerCase:         true,
+					UniqueNullsDistinct: true,
+					NameReplacer:        nameReplacer,
 					Config: contracts.Config{
-						Dsn:      dsn,
-						Host:     host,
-						Port:     port,
-						Database: database,
-						Username: username,
-						Password: password,
+						Auth:    auth,
+						TLS:     tls,
+						Retry:   retry,
+						Session: session,
+						Options: map[string]string{
+							"app name": "goravel",
+						},
+						Dsn:                 dsn,
+						Host:                host,
+						Port:                port,
+						Instance:            instance,
+						Database:            database,
+						Username:            username,
+						Password:            password,
+						AppName:             appName,
+						ConnectionTimeout:   connectionTimeout,
+						DialTimeout:         dialTimeout,
+						KeepAlive:           keepAlive,
+						PacketSize:          packetSize,
+						FailoverPartner:     failoverPartner,
+						FailoverPort:        failoverPort,
+						MultiSubnetFailover: true,
 					},
 					Timezone: timezone,
 				},
@@ -220,38 +379,73 @@ func (s *ConfigTestSuite) TestFillDefault() {
 			name: "success when configs have item",
 			configs: []contracts.Config{
 				{
-					Dsn:      dsn,
-					Host:     host,
-					Port:     port,
-					Database: database,
-					Username: username,
-					Password: password,
+					Auth: contracts.Auth{
+						Method: contracts.AuthActiveDirectoryDefault,
+					},
+					Dsn:                 dsn,
+					Host:                host,
+					Port:                port,
+					Instance:            instance,
+					Database:            database,
+					Username:            username,
+					Password:            password,
+					AppName:             appName,
+					ConnectionTimeout:   connectionTimeout,
+					DialTimeout:         dialTimeout,
+					KeepAlive:           keepAlive,
+					PacketSize:          packetSize,
+					FailoverPartner:     failoverPartner,
+					FailoverPort:        failoverPort,
+					MultiSubnetFailover: true,
 				},
 			},
 			setup: func() {
 				s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.prefix", s.connection)).Return(prefix).Once()
+				s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.collation", s.connection)).Return(collation).Once()
+				s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.full_text_catalog", s.connection)).Return(fullTextCatalog).Once()
+				s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.schema", s.connection)).Return("").Once()
 				s.mockConfig.EXPECT().GetBool(fmt.Sprintf("database.connections.%s.singular", s.connection)).Return(singular).Once()
 				s.mockConfig.EXPECT().GetBool(fmt.Sprintf("database.connections.%s.no_lower_case", s.connection)).Return(true).Once()
+				s.mockConfig.EXPECT().GetBool(fmt.Sprintf("database.connections.%s.unique_nulls_distinct", s.connection)).Return(true).Once()
 				s.mockConfig.EXPECT().Get(fmt.Sprintf("database.connections.%s.name_replacer", s.connection)).Return(nameReplacer).Once()
+				s.mockConfig.EXPECT().Get(fmt.Sprintf("database.connections.%s.options", s.connection)).Return(nil).Once()
+				s.mockConfig.EXPECT().Get(fmt.Sprintf("database.connections.%s.tls", s.connection)).Return(nil).Once()
+				s.mockConfig.EXPECT().Get(fmt.Sprintf("database.connections.%s.retry", s.connection)).Return(nil).Once()
+				s.mockConfig.EXPECT().Get(fmt.Sprintf("database.connections.%s.session", s.connection)).Return(nil).Once()
 				s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.charset", s.connection)).Return(charset).Once()
 				s.mockConfig.EXPECT().GetString(fmt.Sprintf("database.connections.%s.timezone", s.connection)).Return(timezone).Once()
 			},
 			expectConfigs: []contracts.FullConfig{
 				{
-					Connection:   s.connection,
-					Driver:       Name,
-					Prefix:       prefix,
-					Singular:     singular,
-					Charset:      charset,
-					NoLowerCase:  true,
-					NameReplacer: nameReplacer,
+					Connection:          s.connection,
+					Driver:              Name,
+					Prefix:              prefix,
+					Collation:           collation,
+					FullTextCatalog:     fullTextCatalog,
+					Singular:            singular,
+					Charset:             charset,
+					NoLowerCase:         true,
+					UniqueNullsDistinct: true,
+					NameReplacer:        nameReplacer,
 					Config: contracts.Config{
-						Dsn:      dsn,
-						Database: database,
-						Host:     host,
-						Port:     port,
-						Username: username,
-						Password: password,
+						Auth: contracts.Auth{
+							Method: contracts.AuthActiveDirectoryDefault,
+						},
+						Dsn:                 dsn,
+						Database:            database,
+						Host:                host,
+						Port:                port,
+						Instance:            instance,
+						Username:            username,
+						Password:            password,
+						AppName:             appName,
+						ConnectionTimeout:   connectionTimeout,
+						DialTimeout:         dialTimeout,
+						KeepAlive:           keepAlive,
+						PacketSize:          packetSize,
+						FailoverPartner:     failoverPartner,
+						FailoverPort:        failoverPort,
+						MultiSubnetFailover: true,
 					},
 					Timezone: timezone,
 				},
@@ -1,9 +1,33 @@
 package contracts
 
 import (
+	"time"
+
 	contractsconfig "github.com/goravel/framework/contracts/config"
 )
 
+// Authentication methods supported by Auth.Method, they are passed to the driver as the fedauth parameter.
+const (
+	AuthActiveDirectoryDefault          = "ActiveDirectoryDefault"
+	AuthActiveDirectoryManagedIdentity  = "ActiveDirectoryManagedIdentity"
+	AuthActiveDirectoryPassword         = "ActiveDirectoryPassword"
+	AuthActiveDirectoryServicePrincipal = "ActiveDirectoryServicePrincipal"
+)
+
+// Encryption modes supported by TLS.Encrypt.
+const (
+	EncryptDisable = "disable"
+	EncryptFalse   = "false"
+	EncryptTrue    = "true"
+	EncryptStrict  = "strict"
+)
+
+// Application intents supported by Config.ApplicationIntent.
+const (
+	ApplicationIntentReadOnly  = "ReadOnly"
+	ApplicationIntentReadWrite = "ReadWrite"
+)
+
 type ConfigBuilder interface {
 	Config() contractsconfig.Config
 	Connection() string
@@ -16,25 +40,129 @@ type Replacer interface {
 	Replace(name string) string
 }
 
+// Auth Microsoft Entra ID (Azure AD) authentication, SQL Server authentication is used when Method is empty.
+type Auth struct {
+	// Method One of the Auth* constants.
+	Method string
+	// ClientID The application (client) ID: the public client for ActiveDirectoryPassword, the service principal
+	// for ActiveDirectoryServicePrincipal, or the user-assigned identity for ActiveDirectoryManagedIdentity.
+	ClientID string
+	// ClientSecret The secret of the service principal, not needed when CertificatePath is set.
+	ClientSecret string
+	// TenantID The tenant of the service principal, the tenant of the server is used when empty.
+	TenantID string
+	// CertificatePath The PEM or PKCS#12 certificate file of the service principal.
+	CertificatePath string
+	// CertificatePassword The password of the certificate file.
+	CertificatePassword string
+	// ResourceID The resource ID of the user-assigned managed identity.
+	ResourceID string
+}
+
+// TLS The encryption of the connection.
+type TLS struct {
+	// Encrypt One of the Encrypt* constants, the driver encrypts the login packet only when empty or false,
+	// EncryptStrict uses TDS 8.0 and requires SQL Server 2022 or Azure SQL.
+	Encrypt string
+	// TrustServerCertificate Skip the validation of the server certificate.
+	TrustServerCertificate bool
+	// Certificate The CA certificate file used to validate the server certificate.
+	Certificate string
+	// HostNameInCertificate The host name expected in the server certificate, the host is used when empty.
+	HostNameInCertificate string
+	// MinVersion The minimum TLS version: 1.0, 1.1, 1.2 or 1.3.
+	MinVersion string
+}
+
+// Retry The retry policy of transient faults, such as Azure SQL reconfigurations and Availability Group failovers.
+// Opening connections and SELECT statements outside of transactions are retried, it's disabled when MaxAttempts
+// is less than 2.
+type Retry struct {
+	// MaxAttempts The maximum number of attempts, including the first one.
+	MaxAttempts int
+	// InitialBackoff The wait before the first retry, it doubles on every retry with jitter, 1 second when 0.
+	InitialBackoff time.Duration
+	// MaxBackoff The upper bound of the wait, 30 seconds when 0.
+	MaxBackoff time.Duration
+	// Errors The error numbers to retry, 4060, 10928, 40501, 40613 and 49918 when empty.
+	Errors []int32
+}
+
+// Session The SET options applied on every new or reused connection, empty fields keep the server defaults.
+type Session struct {
+	// XactAbort SET XACT_ABORT ON, roll back the whole transaction when a statement fails.
+	XactAbort bool
+	// ArithAbort SET ARITHABORT ON, the default of SSMS, so that queries get the same plans.
+	ArithAbort bool
+	// LockTimeout SET LOCK_TIMEOUT in milliseconds, -1 waits forever.
+	LockTimeout int
+	// DateFirst SET DATEFIRST, the first day of the week from 1 (Monday) to 7 (Sunday).
+	DateFirst int
+	// Language SET LANGUAGE, such as us_english.
+	Language string
+	// DeadlockPriority SET DEADLOCK_PRIORITY: LOW, NORMAL, HIGH or a number from -10 to 10.
+	DeadlockPriority string
+	// IsolationLevel SET TRANSACTION ISOLATION LEVEL: READ UNCOMMITTED, READ COMMITTED, REPEATABLE READ,
+	// SNAPSHOT or SERIALIZABLE.
+	IsolationLevel string
+	// Statements Any other statements, they run after the options above.
+	Statements []string
+}
+
 // Config Used in config/database.go
 type Config struct {
+	Auth     Auth
+	TLS      TLS
+	Retry    Retry
+	Session  Session
 	Dsn      string
 	Host     string
 	Port     int
 	Database string
 	Username string
 	Password string
+	// Instance The named instance, its port is resolved by the SQL Server Browser when Port is 0.
+	// It can also be set by Host in the SERVER\INSTANCE form.
+	Instance string
+	// AppName The application name reported to the server.
+	AppName string
+	// ConnectionTimeout The seconds to wait for a connection to be established, 0 means no timeout.
+	ConnectionTimeout int
+	// DialTimeout The seconds to wait for the TCP dial, the driver default is 15.
+	DialTimeout int
+	// KeepAlive The seconds between TCP keep-alive pings, the driver default is 30.
+	KeepAlive int
+	// PacketSize The TDS packet size in bytes, between 512 and 32767.
+	PacketSize int
+	// FailoverPartner The database mirroring failover partner.
+	FailoverPartner string
+	// FailoverPort The port of the failover partner.
+	FailoverPort int
+	// MultiSubnetFailover Connect to all the IPs of the host in parallel, used for Always On listeners.
+	MultiSubnetFailover bool
+	// ApplicationIntent One of the ApplicationIntent* constants, read configs use ApplicationIntentReadOnly
+	// when empty, so that an Always On listener routes them to a readable secondary.
+	ApplicationIntent string
+	// Options Any other go-mssqldb connection parameters, the typed fields above take precedence.
+	Options map[string]string
 }
 
 // FullConfig Fill the default value for Config
 type FullConfig struct {
 	Config
-	Charset      string
-	Connection   string
-	Driver       string
-	NameReplacer Replacer
-	NoLowerCase  bool
-	Prefix       string
-	Singular     bool
-	Timezone     string
+	Charset    string
+	Collation  string
+	Connection string
+	Driver     string
+	// FullTextCatalog The default catalog of the full-text indexes, it's <connection>_fulltext when empty.
+	FullTextCatalog string
+	NameReplacer    Replacer
+	NoLowerCase     bool
+	Prefix          string
+	Schema          string
+	Singular        bool
+	Timezone        string
+	// UniqueNullsDistinct Create the unique indexes of the nullable columns as filtered indexes, so the columns can have
+	// multiple nulls.
+	UniqueNullsDistinct bool
 }
@@ -1,7 +1,16 @@
 package sqlserver
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
 	"fmt"
+	"math/big"
+	"net"
+	"os"
 	"strconv"
 	"time"
 
@@ -20,6 +29,10 @@ type Docker struct {
 	databaseConfig contractsdocker.DatabaseConfig
 	imageDriver    contractsdocker.ImageDriver
 	process        contractsprocess.Process
+	tls            *contracts.TLS
+	// certificate The self-signed certificate generated by TLS, it's written to the temporary file of tls by Build
+	// and the file is removed by Shutdown. It's nil in the Docker that doesn't own the file.
+	certificate []byte
 }
 
 func NewDocker(config contracts.ConfigBuilder, process contractsprocess.Process, database, username, password string) *Docker {
@@ -33,16 +46,8 @@ func NewDocker(config contracts.ConfigBuilder, process contractsprocess.Process,
 			Port:     1433,
 			Username: username,
 		},
-		imageDriver: testingdocker.NewImageDriver(contractsdocker.Image{
-			Repository: "mcr.microsoft.com/mssql/server",
-			Tag:        "latest",
-			Env: []string{
-				"ACCEPT_EULA=Y",
-				"MSSQL_SA_PASSWORD=" + password,
-			},
-			ExposedPorts: []string{"1433"},
-		}, process),
-		process: process,
+		imageDriver: testingdocker.NewImageDriver(newImage(password), process),
+		process:     process,
 	}
 }
 
@@ -51,6 +56,14 @@ func (r *Docker) Build() error {
 		return err
 	}
 
+	if r.certificate != nil && r.tls.Certificate == "" {
+		certificate, err := writeCertificate(r.certificate)
+		if err != nil {
+			return err
+		}
+		r.tls.Certificate = certificate
+	}
+
 	config := r.imageDriver.Config()
 	r.databaseConfig.ContainerID = config.ContainerID
 	r.databaseConfig.Port = cast.ToInt(supportdocker.ExposedPort(config.ExposedPorts, strconv.Itoa(r.databaseConfig.Port)))
@@ -66,6 +79,11 @@ func (r *Docker) Database(name string) (contractsdocker.DatabaseDriver, error) {
 	docker := NewDocker(r.config, r.process, name, r.databaseConfig.Username, r.databaseConfig.Password)
 	docker.databaseConfig.ContainerID = r.databaseConfig.ContainerID
 	docker.databaseConfig.Port = r.databaseConfig.Port
+	if r.tls != nil {
+		// The copy shares the certificate file, which is only removed by the Docker that wrote it.
+		tls := *r.tls
+		docker.tls = &tls
+	}
 
 	return docker, nil
 }
@@ -124,9 +142,43 @@ func (r *Docker) Reuse(containerID string, port int) error {
 }
 
 func (r *Docker) Shutdown() error {
+	if r.certificate != nil && r.tls.Certificate != "" {
+		_ = os.Remove(r.tls.Certificate)
+	}
+
 	return r.imageDriver.Shutdown()
 }
 
+// TLS Generate a self-signed certificate and force the container to encrypt connections with it, the certificate
+// is saved to a temporary file by Build which is used as the CA certificate of the connections. Call it before Build.
+func (r *Docker) TLS(encrypt string) error {
+	cert, key, err := generateCertificate()
+	if err != nil {
+		return err
+	}
+
+	image := newImage(r.databaseConfig.Password)
+	image.Env = append(image.Env,
+		"MSSQL_TLS_CERT="+base64.StdEncoding.EncodeToString(cert),
+		"MSSQL_TLS_KEY="+base64.StdEncoding.EncodeToString(key),
+	)
+	// The script is wrapped by single quotes, so the variables are expanded in the container instead of the host.
+	image.Cmd = []string{"/bin/bash", "-c", `'mkdir -p /var/opt/mssql/tls` +
+		` && echo "$MSSQL_TLS_CERT" | base64 -d > /var/opt/mssql/tls/cert.pem` +
+		` && echo "$MSSQL_TLS_KEY" | base64 -d > /var/opt/mssql/tls/key.pem` +
+		` && chmod 600 /var/opt/mssql/tls/key.pem` +
+		` && printf "[network]\ntlscert = /var/opt/mssql/tls/cert.pem\ntlskey = /var/opt/mssql/tls/key.pem\ntlsprotocols = 1.2\nforceencryption = 1\n" > /var/opt/mssql/mssql.conf` +
+		` && exec /opt/mssql/bin/sqlservr'`}
+
+	r.Image(image)
+	r.certificate = cert
+	r.tls = &contracts.TLS{
+		Encrypt: encrypt,
+	}
+
+	return nil
+}
+
 func (r *Docker) connect() (*gormio.DB, error) {
 	var (
 		instance *gormio.DB
@@ -136,8 +188,7 @@ func (r *Docker) connect() (*gormio.DB, error) {
 	// docker compose need time to start
 	for i := 0; i < 100; i++ {
 		instance, err = gormio.Open(sqlserver.New(sqlserver.Config{
-			DSN: fmt.Sprintf("sqlserver://%s:%s@%s:%d?database=master",
-				"sa", r.databaseConfig.Password, r.databaseConfig.Host, r.databaseConfig.Port),
+			DSN: r.dsn("master", "sa"),
 		}))
 
 		if err == nil {
@@ -175,8 +226,7 @@ END
 			}
 
 			instance, err = gormio.Open(sqlserver.New(sqlserver.Config{
-				DSN: fmt.Sprintf("sqlserver://%s:%s@%s:%d?database=%s",
-					r.databaseConfig.Username, r.databaseConfig.Password, r.databaseConfig.Host, r.databaseConfig.Port, r.databaseConfig.Database),
+				DSN: r.dsn(r.databaseConfig.Database, r.databaseConfig.Username),
 			}))
 
 			break
@@ -197,14 +247,123 @@ func (r *Docker) close(gormDB *gormio.DB) error {
 	return db.Close()
 }
 
+func (r *Docker) dsn(database, username string) string {
+	fullConfig := contracts.FullConfig{
+		Config: contracts.Config{
+			Host:     r.databaseConfig.Host,
+			Port:     r.databaseConfig.Port,
+			Database: database,
+			Username: username,
+			Password: r.databaseConfig.Password,
+		},
+	}
+	if r.tls != nil {
+		fullConfig.TLS = *r.tls
+	}
+
+	return dsn(fullConfig)
+}
+
 func (r *Docker) resetConfigPort() {
 	writers := r.config.Config().Get(fmt.Sprintf("database.connections.%s.write", r.config.Connection()))
 	if writeConfigs, ok := writers.([]contracts.Config); ok {
 		writeConfigs[0].Port = r.databaseConfig.Port
+		// The write config falls back to the TLS of the connection when its TLS is empty.
+		if r.tls != nil && writeConfigs[0].TLS != (contracts.TLS{}) {
+			writeConfigs[0].TLS = r.resetTLS(writeConfigs[0].TLS)
+		} else if r.tls != nil {
+			r.resetConfigTLS()
+		}
 		r.config.Config().Add(fmt.Sprintf("database.connections.%s.write", r.config.Connection()), writeConfigs)
 
 		return
 	}
 
 	r.config.Config().Add(fmt.Sprintf("database.connections.%s.port", r.config.Connection()), r.databaseConfig.Port)
+	if r.tls != nil {
+		r.resetConfigTLS()
+	}
+}
+
+func (r *Docker) resetConfigTLS() {
+	tls, _ := r.config.Config().Get(fmt.Sprintf("database.connections.%s.tls", r.config.Connection())).(contracts.TLS)
+	r.config.Config().Add(fmt.Sprintf("database.connections.%s.tls", r.config.Connection()), r.resetTLS(tls))
+}
+
+// resetTLS Point the TLS options of the user to the certificate of the container, the other options are kept.
+func (r *Docker) resetTLS(tls contracts.TLS) contracts.TLS {
+	if tls.Encrypt == "" {
+		tls.Encrypt = r.tls.Encrypt
+	}
+	tls.Certificate = r.tls.Certificate
+
+	return tls
+}
+
+func newImage(password string) contractsdocker.Image {
+	return contractsdocker.Image{
+		Repository: "mcr.microsoft.com/mssql/server",
+		Tag:        "latest",
+		Env: []string{
+			"ACCEPT_EULA=Y",
+			"MSSQL_SA_PASSWORD=" + password,
+		},
+		ExposedPorts: []string{"1433"},
+	}
+}
+
+// writeCertificate Write the certificate to a temporary file, returns the path of the file.
+func writeCertificate(cert []byte) (string, error) {
+	file, err := os.CreateTemp("", "goravel-sqlserver-*.pem")
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	if _, err := file.Write(cert); err != nil {
+		_ = os.Remove(file.Name())
+
+		return "", err
+	}
+
+	return file.Name(), nil
+}
+
+// generateCertificate Generate a self-signed certificate for localhost, returns the certificate and the key in PEM.
+func generateCertificate() ([]byte, []byte, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               pkix.Name{CommonName: "localhost"},
+		DNSNames:              []string{"localhost"},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().AddDate(1, 0, 0),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyDer, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDer}), nil
 }
@@ -3,12 +3,16 @@
 package sqlserver
 
 import (
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
+	"os"
 	"testing"
 
 	"github.com/goravel/framework/mocks/config"
 	"github.com/goravel/framework/process"
 	"github.com/goravel/sqlserver/contracts"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -100,6 +104,62 @@ func (s *DockerTestSuite) TestDatabase() {
 	s.Nil(s.docker.Shutdown())
 }
 
+func (s *DockerTestSuite) TestUpdateWithRowVersion() {
+	s.Nil(s.docker.Build())
+
+	instance, err := s.docker.connect()
+	s.Nil(err)
+
+	s.Nil(instance.Exec(`
+CREATE TABLE users (
+	id bigint NOT NULL IDENTITY(1,1),
+	name varchar(255) NOT NULL,
+	row_version rowversion NOT NULL,
+	PRIMARY KEY (id)
+);
+`).Error)
+	s.Nil(instance.Exec(`
+CREATE TRIGGER users_updated ON users AFTER UPDATE AS
+BEGIN
+	SET NOCOUNT ON;
+END;
+`).Error)
+	s.Nil(instance.Exec("INSERT INTO users (name) VALUES ('hello');").Error)
+
+	var version RowVersion
+	s.Nil(instance.Raw("SELECT row_version FROM users WHERE id = 1;").Scan(&version).Error)
+
+	sql, args := NewGrammar("").CompileUpdateWithRowVersion("users", "id", "row_version", map[string]any{"name": "goravel"})
+
+	var versions []RowVersion
+	s.Nil(instance.Raw(sql, append(args, 1, version)...).Scan(&versions).Error)
+	s.Len(versions, 1)
+	s.NotEqual(version, versions[0])
+
+	versions = nil
+	s.Nil(instance.Raw(sql, append(args, 1, version)...).Scan(&versions).Error)
+	s.Empty(versions)
+
+	s.Nil(s.docker.Shutdown())
+}
+
+func (s *DockerTestSuite) TestTLS() {
+	s.Nil(s.docker.TLS(contracts.EncryptStrict))
+	s.Empty(s.docker.tls.Certificate)
+	s.Nil(s.docker.Build())
+	s.FileExists(s.docker.tls.Certificate)
+
+	instance, err := s.docker.connect()
+	s.Nil(err)
+
+	var encrypted string
+	s.Nil(instance.Raw("SELECT encrypt_option FROM sys.dm_exec_connections WHERE session_id = @@SPID").Scan(&encrypted).Error)
+	s.Equal("TRUE", encrypted)
+
+	s.Nil(s.docker.Shutdown())
+	s.NoFileExists(s.docker.tls.Certificate)
+}
+
 func (s *DockerTestSuite) TestReady() {
 	s.Run("config contains write config", func() {
 		s.SetupTest()
@@ -132,3 +192,76 @@ func (s *DockerTestSuite) TestReady() {
 		s.Nil(s.docker.Shutdown())
 	})
 }
+
+func TestDockerTLS(t *testing.T) {
+	mockConfig := config.NewConfig(t)
+	docker := NewDocker(NewConfig(mockConfig, "default"), process.New(), "goravel", "goravel", "Framework!123")
+	assert.Nil(t, docker.TLS(contracts.EncryptStrict))
+	assert.Empty(t, docker.tls.Certificate)
+
+	certificate, err := writeCertificate(docker.certificate)
+	assert.Nil(t, err)
+	defer os.Remove(certificate)
+	docker.tls.Certificate = certificate
+
+	t.Run("the database copies the tls", func(t *testing.T) {
+		databaseDriver, err := docker.Database("another")
+		assert.Nil(t, err)
+
+		child := databaseDriver.(*Docker)
+		assert.Equal(t, *docker.tls, *child.tls)
+		assert.NotSame(t, docker.tls, child.tls)
+		assert.Nil(t, child.certificate)
+	})
+
+	t.Run("reset the certificate of the write config", func(t *testing.T) {
+		mockConfig.EXPECT().Get("database.connections.default.write").Return([]contracts.Config{
+			{
+				Host: "127.0.0.1",
+				TLS:  contracts.TLS{Encrypt: contracts.EncryptStrict, HostNameInCertificate: "localhost"},
+			},
+		}).Once()
+		mockConfig.EXPECT().Add("database.connections.default.write", []contracts.Config{
+			{
+				Host: "127.0.0.1",
+				Port: docker.databaseConfig.Port,
+				TLS:  contracts.TLS{Encrypt: contracts.EncryptStrict, HostNameInCertificate: "localhost", Certificate: certificate},
+			},
+		}).Once()
+
+		docker.resetConfigPort()
+	})
+
+	t.Run("reset the certificate of the connection config", func(t *testing.T) {
+		mockConfig.EXPECT().Get("database.connections.default.write").Return(nil).Once()
+		mockConfig.EXPECT().Add("database.connections.default.port", docker.databaseConfig.Port).Once()
+		mockConfig.EXPECT().Get("database.connections.default.tls").Return(contracts.TLS{MinVersion: "1.2"}).Once()
+		mockConfig.EXPECT().Add("database.connections.default.tls", contracts.TLS{
+			Encrypt:     contracts.EncryptStrict,
+			Certificate: certificate,
+			MinVersion:  "1.2",
+		}).Once()
+
+		docker.resetConfigPort()
+	})
+}
+
+func TestGenerateCertificate(t *testing.T) {
+	cert, key, err := generateCertificate()
+	assert.Nil(t, err)
+
+	certBlock, _ := pem.Decode(cert)
+	assert.Equal(t, "CERTIFICATE", certBlock.Type)
+	keyBlock, _ := pem.Decode(key)
+	assert.Equal(t, "PRIVATE KEY", keyBlock.Type)
+
+	certificate, err := x509.ParseCertificate(certBlock.Bytes)
+	assert.Nil(t, err)
+	assert.Nil(t, certificate.VerifyHostname("localhost"))
+	assert.Nil(t, certificate.VerifyHostname("127.0.0.1"))
+
+	pool := x509.NewCertPool()
+	pool.AddCert(certificate)
+	_, err = certificate.Verify(x509.VerifyOptions{Roots: pool, DNSName: "localhost"})
+	assert.Nil(t, err)
+}
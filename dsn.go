@@ -0,0 +1,89 @@
+package sqlserver
+
+import (
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/goravel/sqlserver/contracts"
+)
+
+func dsn(fullConfig contracts.FullConfig) string {
+	if fullConfig.Dsn != "" {
+		return fullConfig.Dsn
+	}
+	if fullConfig.Host == "" {
+		return ""
+	}
+
+	username, password, params := authParams(fullConfig)
+
+	query := url.Values{}
+	// The keys are case-insensitive in go-mssqldb, lower them to avoid passing the same key twice.
+	for key, value := range fullConfig.Options {
+		query.Set(strings.ToLower(key), value)
+	}
+
+	setDsnString(query, "database", fullConfig.Database)
+	setDsnString(query, "timezone", fullConfig.Timezone)
+	setDsnString(query, "app name", fullConfig.AppName)
+	setDsnInt(query, "connection timeout", fullConfig.ConnectionTimeout)
+	setDsnInt(query, "dial timeout", fullConfig.DialTimeout)
+	setDsnInt(query, "keepalive", fullConfig.KeepAlive)
+	setDsnInt(query, "packet size", fullConfig.PacketSize)
+	setDsnString(query, "failoverpartner", fullConfig.FailoverPartner)
+	setDsnInt(query, "failoverport", fullConfig.FailoverPort)
+	if fullConfig.MultiSubnetFailover {
+		query.Set("multisubnetfailover", "true")
+	}
+	setDsnString(query, "applicationintent", fullConfig.ApplicationIntent)
+	setDsnString(query, "encrypt", fullConfig.TLS.Encrypt)
+	if fullConfig.TLS.TrustServerCertificate {
+		query.Set("trustservercertificate", "true")
+	}
+	setDsnString(query, "certificate", fullConfig.TLS.Certificate)
+	setDsnString(query, "hostnameincertificate", fullConfig.TLS.HostNameInCertificate)
+	setDsnString(query, "tlsmin", fullConfig.TLS.MinVersion)
+	for key, values := range params {
+		query[key] = values
+	}
+
+	host, instance := fullConfig.Host, fullConfig.Instance
+	if i := strings.Index(host, `\`); i >= 0 {
+		if instance == "" {
+			instance = host[i+1:]
+		}
+		host = host[:i]
+	}
+	if fullConfig.Port > 0 {
+		host = net.JoinHostPort(host, strconv.Itoa(fullConfig.Port))
+	}
+
+	dsn := url.URL{
+		Scheme:   "sqlserver",
+		Host:     host,
+		RawQuery: query.Encode(),
+	}
+	// go-mssqldb asks the SQL Server Browser for the port of the instance when the port is missing.
+	if instance != "" {
+		dsn.Path = "/" + instance
+	}
+	if username != "" || password != "" {
+		dsn.User = url.UserPassword(username, password)
+	}
+
+	return dsn.String()
+}
+
+func setDsnInt(query url.Values, key string, value int) {
+	if value > 0 {
+		query.Set(key, strconv.Itoa(value))
+	}
+}
+
+func setDsnString(query url.Values, key, value string) {
+	if value != "" {
+		query.Set(key, value)
+	}
+}
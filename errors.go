@@ -1,8 +1,159 @@
 package sqlserver
 
-import "github.com/goravel/framework/errors"
+import (
+	"regexp"
+	"strings"
+
+	"github.com/goravel/framework/errors"
+	mssql "github.com/microsoft/go-mssqldb"
+)
+
+var (
+	FailedToGenerateDSN  = errors.New("failed to generate DSN, please check the database configuration")
+	ConfigNotFound       = errors.New("not found database configuration")
+	InvalidSessionOption = errors.New("invalid session %s: %v")
+	PrimaryKeyNotFound   = errors.New("primary key not found in the model %s")
+	InvalidRowVersion    = errors.New("invalid row version: %v")
+	RowVersionNotFound   = errors.New("row version field not found in the model %s")
+	NoValuesToUpdate     = errors.New("no values to update in the model %s")
+
+	// RowVersionConflict The row was updated or deleted since it was read, returned by UpdateWithRowVersion.
+	RowVersionConflict = errors.New("row version conflict, the row was changed or deleted since it was read")
+
+	// Errors translated from SQL Server error numbers by TranslateError.
+	UniqueViolation     = errors.New("unique constraint violated")
+	ForeignKeyViolation = errors.New("foreign key constraint violated")
+	CheckViolation      = errors.New("check constraint violated")
+	Deadlock            = errors.New("transaction was chosen as the deadlock victim")
+	SnapshotConflict    = errors.New("snapshot isolation transaction aborted due to update conflict")
+	LockTimeout         = errors.New("lock request timed out")
+	StringTruncation    = errors.New("string or binary data would be truncated")
+	PermissionDenied    = errors.New("permission denied")
+)
 
 var (
-	FailedToGenerateDSN = errors.New("failed to generate DSN, please check the database configuration")
-	ConfigNotFound      = errors.New("not found database configuration")
+	constraintRegexp  = regexp.MustCompile(`(?:constraint|unique index) ['"]([^'"]+)['"]`)
+	tableRegexp       = regexp.MustCompile(`(?:object|table) ['"]([^'"]+)['"]`)
+	columnRegexp      = regexp.MustCompile(`column ['"]([^'"]+)['"]`)
+	translatedNumbers = map[int32]error{
+		229:  PermissionDenied,
+		547:  ForeignKeyViolation,
+		1205: Deadlock,
+		1222: LockTimeout,
+		2601: UniqueViolation,
+		2627: UniqueViolation,
+		2628: StringTruncation,
+		3960: SnapshotConflict,
+		8152: StringTruncation,
+	}
 )
+
+// Error A SQL Server error translated by TranslateError, errors.Is matches both Kind and the original error.
+type Error struct {
+	// Err The original error returned by the driver.
+	Err error
+	// Kind One of the translated errors, such as UniqueViolation.
+	Kind error
+	// Number The SQL Server error number.
+	Number int32
+	// Constraint The name of the violated constraint or unique index.
+	Constraint string
+	// Table The table in the message, it may be qualified by the schema and the database.
+	Table string
+	// Column The column in the message, set for foreign key violations and string truncations.
+	Column string
+}
+
+func (r *Error) Error() string {
+	return r.Err.Error()
+}
+
+func (r *Error) Unwrap() []error {
+	return []error{r.Kind, r.Err}
+}
+
+// TranslateError Translates a driver error to *Error when its number is known, otherwise returns it as is.
+func TranslateError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var translated *Error
+	if errors.As(err, &translated) {
+		return translated
+	}
+
+	var sqlErr mssql.Error
+	if !errors.As(err, &sqlErr) {
+		return err
+	}
+
+	kind, ok := translatedNumbers[sqlErr.Number]
+	if !ok {
+		return err
+	}
+
+	// 547 is raised by both foreign key and check constraints.
+	if sqlErr.Number == 547 && strings.Contains(sqlErr.Message, "CHECK constraint") {
+		kind = CheckViolation
+	}
+
+	translated = &Error{
+		Err:    err,
+		Kind:   kind,
+		Number: sqlErr.Number,
+	}
+	if matches := constraintRegexp.FindStringSubmatch(sqlErr.Message); matches != nil {
+		translated.Constraint = matches[1]
+	}
+	if matches := tableRegexp.FindStringSubmatch(sqlErr.Message); matches != nil {
+		translated.Table = matches[1]
+	}
+	if matches := columnRegexp.FindStringSubmatch(sqlErr.Message); matches != nil {
+		translated.Column = matches[1]
+	}
+
+	return translated
+}
+
+// ConstraintName Returns the name of the violated constraint or unique index, empty when err is not a violation.
+func ConstraintName(err error) string {
+	var translated *Error
+	if errors.As(TranslateError(err), &translated) {
+		return translated.Constraint
+	}
+
+	return ""
+}
+
+func IsUniqueViolation(err error) bool {
+	return errors.Is(TranslateError(err), UniqueViolation)
+}
+
+func IsForeignKeyViolation(err error) bool {
+	return errors.Is(TranslateError(err), ForeignKeyViolation)
+}
+
+func IsCheckViolation(err error) bool {
+	return errors.Is(TranslateError(err), CheckViolation)
+}
+
+func IsDeadlock(err error) bool {
+	return errors.Is(TranslateError(err), Deadlock)
+}
+
+func IsSnapshotConflict(err error) bool {
+	return errors.Is(TranslateError(err), SnapshotConflict)
+}
+
+func IsLockTimeout(err error) bool {
+	return errors.Is(TranslateError(err), LockTimeout)
+}
+
+func IsStringTruncation(err error) bool {
+	return errors.Is(TranslateError(err), StringTruncation)
+}
+
+func IsPermissionDenied(err error) bool {
+	return errors.Is(TranslateError(err), PermissionDenied)
+}
@@ -0,0 +1,146 @@
+package sqlserver
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	mssql "github.com/microsoft/go-mssqldb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTranslateError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		is       func(err error) bool
+		expected *Error
+	}{
+		{
+			name: "primary key violation",
+			err:  mssql.Error{Number: 2627, Message: "Violation of PRIMARY KEY constraint 'PK_users'. Cannot insert duplicate key in object 'dbo.users'. The duplicate key value is (1)."},
+			is:   IsUniqueViolation,
+			expected: &Error{
+				Kind:       UniqueViolation,
+				Number:     2627,
+				Constraint: "PK_users",
+				Table:      "dbo.users",
+			},
+		},
+		{
+			name: "unique index violation",
+			err:  mssql.Error{Number: 2601, Message: "Cannot insert duplicate key row in object 'dbo.users' with unique index 'users_email_unique'. The duplicate key value is (a@goravel.dev)."},
+			is:   IsUniqueViolation,
+			expected: &Error{
+				Kind:       UniqueViolation,
+				Number:     2601,
+				Constraint: "users_email_unique",
+				Table:      "dbo.users",
+			},
+		},
+		{
+			name: "foreign key violation",
+			err:  mssql.Error{Number: 547, Message: `The INSERT statement conflicted with the FOREIGN KEY constraint "posts_user_id_foreign". The conflict occurred in database "goravel", table "dbo.users", column 'id'.`},
+			is:   IsForeignKeyViolation,
+			expected: &Error{
+				Kind:       ForeignKeyViolation,
+				Number:     547,
+				Constraint: "posts_user_id_foreign",
+				Table:      "dbo.users",
+				Column:     "id",
+			},
+		},
+		{
+			name: "check violation",
+			err:  mssql.Error{Number: 547, Message: `The INSERT statement conflicted with the CHECK constraint "users_age_check". The conflict occurred in database "goravel", table "dbo.users", column 'age'.`},
+			is:   IsCheckViolation,
+			expected: &Error{
+				Kind:       CheckViolation,
+				Number:     547,
+				Constraint: "users_age_check",
+				Table:      "dbo.users",
+				Column:     "age",
+			},
+		},
+		{
+			name: "deadlock",
+			err:  mssql.Error{Number: 1205, Message: "Transaction (Process ID 52) was deadlocked on lock resources with another process and has been chosen as the deadlock victim. Rerun the transaction."},
+			is:   IsDeadlock,
+			expected: &Error{
+				Kind:   Deadlock,
+				Number: 1205,
+			},
+		},
+		{
+			name: "snapshot conflict",
+			err:  mssql.Error{Number: 3960, Message: "Snapshot isolation transaction aborted due to update conflict. You cannot use snapshot isolation to access table 'dbo.users' directly or indirectly in database 'goravel' to update, delete, or insert the row that has been modified or deleted by another transaction. Retry the transaction or change the isolation level for the update/delete statement."},
+			is:   IsSnapshotConflict,
+			expected: &Error{
+				Kind:   SnapshotConflict,
+				Number: 3960,
+				Table:  "dbo.users",
+			},
+		},
+		{
+			name: "lock timeout",
+			err:  mssql.Error{Number: 1222, Message: "Lock request time out period exceeded."},
+			is:   IsLockTimeout,
+			expected: &Error{
+				Kind:   LockTimeout,
+				Number: 1222,
+			},
+		},
+		{
+			name: "string truncation",
+			err:  mssql.Error{Number: 2628, Message: "String or binary data would be truncated in table 'goravel.dbo.users', column 'name'. Truncated value: 'goravel'."},
+			is:   IsStringTruncation,
+			expected: &Error{
+				Kind:   StringTruncation,
+				Number: 2628,
+				Table:  "goravel.dbo.users",
+				Column: "name",
+			},
+		},
+		{
+			name: "permission denied",
+			err:  mssql.Error{Number: 229, Message: "The SELECT permission was denied on the object 'users', database 'goravel', schema 'dbo'."},
+			is:   IsPermissionDenied,
+			expected: &Error{
+				Kind:   PermissionDenied,
+				Number: 229,
+				Table:  "users",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := fmt.Errorf("wrapped: %w", test.err)
+			test.expected.Err = err
+
+			translated := TranslateError(err)
+			assert.Equal(t, test.expected, translated)
+			assert.True(t, errors.Is(translated, test.expected.Kind))
+
+			var sqlErr mssql.Error
+			assert.True(t, errors.As(translated, &sqlErr))
+			assert.Equal(t, test.err, sqlErr)
+			assert.True(t, test.is(err))
+			assert.True(t, test.is(translated))
+			assert.Equal(t, test.expected.Constraint, ConstraintName(err))
+		})
+	}
+
+	t.Run("keep unknown errors", func(t *testing.T) {
+		assert.Nil(t, TranslateError(nil))
+
+		err := mssql.Error{Number: 208, Message: "Invalid object name 'users'."}
+		assert.Equal(t, err, TranslateError(err))
+		assert.False(t, IsUniqueViolation(err))
+		assert.Empty(t, ConstraintName(err))
+
+		err2 := errors.New("error")
+		assert.Equal(t, err2, TranslateError(err2))
+		assert.False(t, IsDeadlock(err2))
+	})
+}
@@ -0,0 +1,117 @@
+package sqlserver
+
+import (
+	"fmt"
+	"strings"
+
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
+	"gorm.io/gorm/clause"
+)
+
+// fullTextAlias The alias of the CONTAINSTABLE or FREETEXTTABLE joined by FullTextRankJoin.
+const fullTextAlias = "ft"
+
+// FullTextRank Order the rows joined by FullTextRankJoin by their rank, the best matches first.
+var FullTextRank = NewWrap("").Column(fullTextAlias+".RANK") + " desc"
+
+// FullTextClause The full-text predicate or join built by the full-text helpers. It's a clause of gorm and a Sqlizer of
+// squirrel, so it can be passed to Where of both facades.Orm().Query() and facades.DB(), the same as db.Raw.
+type FullTextClause struct {
+	clause.Expr
+}
+
+func (r FullTextClause) ToSql() (string, []any, error) {
+	return r.SQL, r.Vars, nil
+}
+
+// FullTextIndex The SQL Server options of the full-text index, a table has one full-text index at most.
+type FullTextIndex struct {
+	// Catalog The full-text catalog of the index, the catalog of the connection is used when empty.
+	Catalog string
+	// ChangeTracking AUTO, MANUAL, OFF or OFF, NO POPULATION, SQL Server uses AUTO when empty.
+	ChangeTracking string
+	// Language The language of the columns that aren't in Languages.
+	Language string
+	// KeyIndex The unique, single-column, non-nullable index of the table, the primary key is used when empty.
+	KeyIndex string
+	// Languages The language of the columns, an LCID such as 1033 or a name such as English, keyed by the column.
+	Languages map[string]string
+}
+
+// CreateFullTextIndex Create the full-text index of the columns of the table with the options. SQL Server can't create
+// it in a transaction, and the migrations and the blueprint always run in one, so table.FullText does nothing and the
+// index is created by a connection out of the transaction of the migration. The table has to be committed already,
+// create the index in a migration after the one that creates the table.
+//
+//	driver.CreateFullTextIndex(facades.Orm(), "posts", []string{"title", "body"}, sqlserver.FullTextIndex{Language: "English"})
+func (r *Sqlserver) CreateFullTextIndex(orm contractsorm.Orm, table string, columns []string, options FullTextIndex) error {
+	_, err := orm.Connection(orm.Name()).Query().Exec(r.grammar().CompileCreateFullTextIndex(table, columns, options))
+
+	return err
+}
+
+// DropFullTextIndex Drop the full-text index of the table by a connection out of the transaction of the migration,
+// table.DropFullText does nothing for the same reason as table.FullText.
+func (r *Sqlserver) DropFullTextIndex(orm contractsorm.Orm, table string) error {
+	_, err := orm.Connection(orm.Name()).Query().Exec(r.grammar().CompileDropFullTextIndex(table))
+
+	return err
+}
+
+// FullTextContains The CONTAINS predicate that matches the search condition on the full-text indexed columns, * matches
+// all of them. The search condition can use the terms built by FullTextPrefix and FullTextNear.
+//
+//	facades.Orm().Query().Where(sqlserver.FullTextContains([]string{"title", "body"}, sqlserver.FullTextPrefix("data"), false)).Find(&posts)
+func FullTextContains(columns []string, search string, isNot bool) FullTextClause {
+	return newFullTextClause(NewGrammar("").CompileFullTextContains(columns, search, isNot))
+}
+
+// FullTextFreeText The FREETEXT predicate that matches the meaning of the search text on the full-text indexed
+// columns, * matches all of them.
+func FullTextFreeText(columns []string, search string, isNot bool) FullTextClause {
+	return newFullTextClause(NewGrammar("").CompileFullTextFreeText(columns, search, isNot))
+}
+
+// FullTextRankJoin The join of CONTAINSTABLE, or FREETEXTTABLE when freeText is true, on the key column of the table,
+// the table is the name in the database, with the prefix of the connection. Order by FullTextRank to get the best
+// matches first.
+//
+//	join := sqlserver.FullTextRankJoin("posts", "id", []string{"title", "body"}, "database", false)
+//	facades.DB().Table("posts").Join(join.SQL, join.Vars...).OrderByRaw(sqlserver.FullTextRank).Get(&posts)
+func FullTextRankJoin(table, key string, columns []string, search string, freeText bool) FullTextClause {
+	return newFullTextClause(NewGrammar("").CompileFullTextRank(table, key, columns, search, freeText))
+}
+
+// FullTextPrefix Build the prefix term of the CONTAINS search condition, it matches the words that start with the
+// term, or with every word of it.
+//
+//	sqlserver.FullTextPrefix("data") // "data*"
+func FullTextPrefix(term string) string {
+	return fullTextTerm(term + "*")
+}
+
+// FullTextNear Build the proximity term of the CONTAINS search condition, it matches the rows in which the terms are
+// within the distance of each other, in any order. A distance below 1 means any distance.
+//
+//	sqlserver.FullTextNear(5, "sql", "server") // NEAR(("sql", "server"), 5)
+func FullTextNear(distance int, terms ...string) string {
+	quoted := make([]string, len(terms))
+	for i, term := range terms {
+		quoted[i] = fullTextTerm(term)
+	}
+
+	if distance < 1 {
+		return fmt.Sprintf("NEAR((%s))", strings.Join(quoted, ", "))
+	}
+
+	return fmt.Sprintf("NEAR((%s), %d)", strings.Join(quoted, ", "), distance)
+}
+
+func newFullTextClause(sql string, args []any) FullTextClause {
+	return FullTextClause{Expr: clause.Expr{SQL: sql, Vars: args}}
+}
+
+// fullTextTerm Quote the term as a phrase, the double quotes in it can't be escaped, so they are removed.
+func fullTextTerm(term string) string {
+	return `"` + strings.ReplaceAll(term, `"`, "") + `"`
+}
@@ -0,0 +1,75 @@
+package sqlserver
+
+import (
+	"testing"
+
+	"github.com/goravel/framework/contracts/database"
+	contractsdb "github.com/goravel/framework/contracts/database/db"
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
+	contractsschema "github.com/goravel/framework/contracts/database/schema"
+	frameworkschema "github.com/goravel/framework/database/schema"
+	mocksdriver "github.com/goravel/framework/mocks/database/driver"
+	mocksorm "github.com/goravel/framework/mocks/database/orm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFullTextTerms(t *testing.T) {
+	assert.Equal(t, `"data*"`, FullTextPrefix("data"))
+	assert.Equal(t, `"data base*"`, FullTextPrefix(`data "base`))
+	assert.Equal(t, `NEAR(("sql", "server"))`, FullTextNear(0, "sql", "server"))
+	assert.Equal(t, `NEAR(("sql", "server", "2022"), 3)`, FullTextNear(3, "sql", "server", "2022"))
+}
+
+func TestFullTextClauses(t *testing.T) {
+	contains := FullTextContains([]string{"title", "body"}, FullTextPrefix("data"), true)
+	sql, args, err := contains.ToSql()
+	assert.NoError(t, err)
+	assert.Equal(t, `not contains(("title", "body"), ?)`, sql)
+	assert.Equal(t, []any{`"data*"`}, args)
+
+	freeText := FullTextFreeText([]string{"*"}, "fast database", false)
+	assert.Equal(t, `freetext(*, ?)`, freeText.SQL)
+	assert.Equal(t, []any{"fast database"}, freeText.Vars)
+
+	join := FullTextRankJoin("goravel_posts", "id", []string{"body"}, "database", true)
+	assert.Equal(t, `freetexttable("goravel_posts", "body", ?) as "ft" on "goravel_posts"."id" = "ft"."KEY"`, join.SQL)
+	assert.Equal(t, []any{"database"}, join.Vars)
+	assert.Equal(t, `"ft"."RANK" desc`, FullTextRank)
+}
+
+func TestFullTextIndex(t *testing.T) {
+	assertDriverError(t, func(sqlserver *Sqlserver, orm contractsorm.Orm) error {
+		return sqlserver.CreateFullTextIndex(orm, "posts", []string{"title", "body"}, FullTextIndex{KeyIndex: "posts_slug_unique"})
+	})
+	assertDriverError(t, func(sqlserver *Sqlserver, orm contractsorm.Orm) error {
+		return sqlserver.DropFullTextIndex(orm, "posts")
+	})
+}
+
+func TestFullTextMigration(t *testing.T) {
+	mockDriver := mocksdriver.NewDriver(t)
+	mockOrm := mocksorm.NewOrm(t)
+	mockQuery := mocksorm.NewQuery(t)
+	mockTx := mocksorm.NewQuery(t)
+
+	mockDriver.EXPECT().Pool().Return(database.Pool{Writers: []database.Config{{Prefix: "goravel_"}}}).Once()
+	mockDriver.EXPECT().Grammar().Return(NewGrammar("goravel_")).Once()
+	mockDriver.EXPECT().Processor().Return(NewProcessor()).Once()
+
+	// The schema builds the blueprint in a transaction, which the full-text index can't be created in, so the blueprint
+	// runs nothing that fails in it.
+	mockOrm.EXPECT().Query().Return(mockQuery).Once()
+	mockQuery.EXPECT().InTransaction().Return(false).Once()
+	mockOrm.EXPECT().Transaction(mock.Anything).RunAndReturn(func(callback func(contractsorm.Query) error) error {
+		return callback(mockTx)
+	}).Once()
+	mockTx.EXPECT().Exec("").Return(&contractsdb.Result{}, nil).Twice()
+
+	schema, err := frameworkschema.NewSchema(nil, nil, mockOrm, mockDriver, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, schema.Table("posts", func(table contractsschema.Blueprint) {
+		table.DropFullText("title")
+		table.FullText("title", "body").Language("english")
+	}))
+}
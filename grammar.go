@@ -2,6 +2,7 @@ package sqlserver
 
 import (
 	"fmt"
+	"maps"
 	"reflect"
 	"regexp"
 	"slices"
@@ -16,29 +17,54 @@ import (
 	"github.com/goravel/framework/support/convert"
 	"github.com/spf13/cast"
 	"gorm.io/gorm/clause"
+
+	"github.com/goravel/sqlserver/contracts"
 )
 
 var _ driver.Grammar = &Grammar{}
 
 type Grammar struct {
-	attributeCommands []string
-	modifiers         []func(driver.Blueprint, driver.ColumnDefinition) string
-	prefix            string
-	serials           []string
-	wrap              *Wrap
+	attributeCommands   []string
+	collatables         []string
+	collation           string
+	fullTextCatalog     string
+	modifiers           []func(driver.Blueprint, driver.ColumnDefinition) string
+	prefix              string
+	schema              string
+	serials             []string
+	uniqueNullsDistinct bool
+	wrap                *Wrap
 }
 
 func NewGrammar(prefix string) *Grammar {
+	return NewGrammarWithConfig(contracts.FullConfig{Prefix: prefix})
+}
+
+// NewGrammarWithConfig Create the grammar with the SQL Server options of the connection, such as the default schema,
+// the collation and the full-text catalog.
+func NewGrammarWithConfig(config contracts.FullConfig) *Grammar {
+	fullTextCatalog := config.FullTextCatalog
+	if fullTextCatalog == "" {
+		fullTextCatalog = config.Connection + "_fulltext"
+	}
+
 	grammar := &Grammar{
-		attributeCommands: []string{schema.CommandComment, schema.CommandDefault},
-		prefix:            prefix,
-		serials:           []string{"bigInteger", "integer", "mediumInteger", "smallInteger", "tinyInteger"},
-		wrap:              NewWrap(prefix),
+		attributeCommands:   []string{schema.CommandComment, schema.CommandDefault},
+		collatables:         []string{"char", "string", "text", "tinyText", "mediumText", "longText"},
+		collation:           config.Collation,
+		fullTextCatalog:     fullTextCatalog,
+		prefix:              config.Prefix,
+		schema:              config.Schema,
+		serials:             []string{"bigInteger", "integer", "mediumInteger", "smallInteger", "tinyInteger"},
+		uniqueNullsDistinct: config.UniqueNullsDistinct,
+		wrap:                NewWrapWithSchema(config.Prefix, config.Schema),
 	}
 	grammar.modifiers = []func(driver.Blueprint, driver.ColumnDefinition) string{
+		grammar.ModifyCollate,
 		grammar.ModifyDefault,
 		grammar.ModifyIncrement,
 		grammar.ModifyNullable,
+		grammar.ModifySrid,
 	}
 
 	return grammar
@@ -48,32 +74,52 @@ func (r *Grammar) CompileAdd(blueprint driver.Blueprint, command *driver.Command
 	return fmt.Sprintf("alter table %s add %s", r.wrap.Table(blueprint.GetTableName()), r.getColumn(blueprint, command.Column))
 }
 
+// CompileAlterSequence Compile the statement that alters the sequence, it restarts with the start value when it's
+// set. The cycle option is always set, the other options are kept when they are zero.
+func (r *Grammar) CompileAlterSequence(sequence Sequence) string {
+	sql := "alter sequence " + r.wrapSequence(sequence)
+	if sequence.Start != nil {
+		sql += fmt.Sprintf(" restart with %d", *sequence.Start)
+	}
+
+	return sql + r.compileSequenceOptions(sequence, true)
+}
+
 func (r *Grammar) CompileChange(blueprint driver.Blueprint, command *driver.Command) []string {
+	if commandOptions(command).computed != nil {
+		// A computed column can't be altered, so it's dropped and added again.
+		table := r.wrap.Table(blueprint.GetTableName())
+
+		return []string{
+			fmt.Sprintf("alter table %s drop column %s", table, r.wrap.Column(command.Column.GetName())),
+			fmt.Sprintf("alter table %s add %s", table, r.getColumn(blueprint, command.Column)),
+		}
+	}
+
 	return []string{
 		r.CompileDropDefaultConstraint(blueprint, command),
 		fmt.Sprintf("alter table %s alter column %s", r.wrap.Table(blueprint.GetTableName()), r.getColumn(blueprint, command.Column)),
 	}
 }
 
-func (r *Grammar) CompileColumns(_, table string) (string, error) {
-	schema, table, err := parseSchemaAndTable(table, "")
+func (r *Grammar) CompileColumns(schema, table string) (string, error) {
+	schema, table, err := parseSchemaAndTable(table, r.defaultSchema(schema))
 	if err != nil {
 		return "", err
 	}
 
 	table = r.prefix + table
-
-	newSchema := "schema_name()"
-	if schema != "" {
-		newSchema = r.wrap.Quote(schema)
-	}
+	newSchema := r.compileSchema(schema)
 
 	return fmt.Sprintf(
 		"select col.name, type.name as type_name, "+
 			"col.max_length as length, col.precision as precision, col.scale as places, "+
 			"col.is_nullable as nullable, def.definition as [default], "+
 			"col.is_identity as autoincrement, col.collation_name as collation, "+
-			"com.definition as [expression], is_persisted as [persisted], "+
+			"case when com.definition is not null then 'as ' + com.definition + case when com.is_persisted = 1 then ' persisted' else '' end "+
+			"when idc.column_id is not null then 'identity(' + cast(idc.seed_value as varchar(40)) + ', ' + cast(idc.increment_value as varchar(40)) + ')' "+
+			"+ coalesce(' last_value ' + cast(idc.last_value as varchar(40)), '') "+
+			"else '' end as extra, "+
 			"cast(prop.value as nvarchar(max)) as comment "+
 			"from sys.columns as col "+
 			"join sys.types as type on col.user_type_id = type.user_type_id "+
@@ -82,23 +128,129 @@ func (r *Grammar) CompileColumns(_, table string) (string, error) {
 			"left join sys.default_constraints def on col.default_object_id = def.object_id and col.object_id = def.parent_object_id "+
 			"left join sys.extended_properties as prop on obj.object_id = prop.major_id and col.column_id = prop.minor_id and prop.name = 'MS_Description' "+
 			"left join sys.computed_columns as com on col.column_id = com.column_id and col.object_id = com.object_id "+
+			"left join sys.identity_columns as idc on col.column_id = idc.column_id and col.object_id = idc.object_id "+
 			"where obj.type in ('U', 'V') and obj.name = %s and scm.name = %s "+
 			"order by col.column_id", r.wrap.Quote(table), newSchema), nil
 }
 
-func (r *Grammar) CompileComment(_ driver.Blueprint, _ *driver.Command) string {
-	return ""
+func (r *Grammar) CompileComment(blueprint driver.Blueprint, command *driver.Command) string {
+	// A changed column without a comment loses its comment, the same as the other drivers.
+	var comment string
+	if command.Column.IsSetComment() {
+		comment = command.Column.GetComment()
+	}
+
+	return r.compileDescription(blueprint, command.Column.GetName(), comment)
 }
 
 func (r *Grammar) CompileCreate(blueprint driver.Blueprint) string {
-	return fmt.Sprintf("create table %s (%s)", r.wrap.Table(blueprint.GetTableName()), strings.Join(r.getColumns(blueprint), ", "))
+	columns := r.getColumns(blueprint)
+	if options := createOptions(blueprint).systemVersioning; options != nil {
+		columns = append(columns, r.compilePeriod(*options))
+
+		return fmt.Sprintf("create table %s (%s) with (%s)",
+			r.wrap.Table(blueprint.GetTableName()), strings.Join(columns, ", "), r.compileSystemVersioning(blueprint.GetTableName(), *options))
+	}
+
+	return fmt.Sprintf("create table %s (%s)", r.wrap.Table(blueprint.GetTableName()), strings.Join(columns, ", "))
+}
+
+// CompileCreateFullTextIndex Compile the statement that creates the full-text index of the columns of the table, and
+// its catalog if it doesn't exist. The key index is the primary key or the first unique index of the table when the
+// options have none.
+func (r *Grammar) CompileCreateFullTextIndex(table string, columns []string, options FullTextIndex) string {
+	catalog := options.Catalog
+	if catalog == "" {
+		catalog = r.fullTextCatalog
+	}
+
+	var definitions []string
+	for _, column := range columns {
+		language := options.Languages[column]
+		if language == "" {
+			language = options.Language
+		}
+		if language == "" {
+			definitions = append(definitions, r.wrap.Column(column))
+			continue
+		}
+		if _, err := strconv.Atoi(language); err != nil {
+			language = quoteString(language)
+		}
+		definitions = append(definitions, fmt.Sprintf("%s language %s", r.wrap.Column(column), language))
+	}
+
+	table = r.wrap.Table(table)
+	key := quoteString(options.KeyIndex)
+	if options.KeyIndex == "" {
+		// The key index is the primary key or the first unique index, their names are generated by SQL Server when
+		// they are defined by the column modifiers.
+		key = fmt.Sprintf("(select top 1 name from sys.indexes where object_id = object_id(%s) and (is_primary_key = 1 or is_unique = 1) "+
+			"order by is_primary_key desc, index_id)", quoteString(table))
+	}
+
+	with := ""
+	if options.ChangeTracking != "" {
+		with = " with change_tracking = " + strings.ToUpper(options.ChangeTracking)
+	}
+
+	return fmt.Sprintf("if not exists (select * from sys.fulltext_catalogs where name = %s) create fulltext catalog %s; "+
+		"declare @key sysname = %s; "+
+		"if @key is null throw 50000, %s, 1; "+
+		"declare @sql nvarchar(max) = %s + quotename(@key) + %s; "+
+		"exec sp_executesql @sql",
+		quoteString(catalog), r.wrap.Column(catalog),
+		key,
+		quoteString(fmt.Sprintf("The full-text index of %s needs a primary key or a unique index.", table)),
+		quoteString(fmt.Sprintf("create fulltext index on %s (%s) key index ", table, strings.Join(definitions, ", "))),
+		quoteString(fmt.Sprintf(" on %s%s", r.wrap.Column(catalog), with)),
+	)
+}
+
+// CompileCreateSequence Compile the statement that creates the sequence, the name is qualified by the default schema
+// of the connection, the prefix of the tables isn't added to it.
+func (r *Grammar) CompileCreateSequence(sequence Sequence) string {
+	sequenceType := sequence.Type
+	if sequenceType == "" {
+		sequenceType = "bigint"
+	}
+
+	sql := fmt.Sprintf("create sequence %s as %s", r.wrapSequence(sequence), sequenceType)
+	if sequence.Start != nil {
+		sql += fmt.Sprintf(" start with %d", *sequence.Start)
+	}
+
+	return sql + r.compileSequenceOptions(sequence, false)
+}
+
+// CompileCreateTableType Compile the user-defined table type with the columns, primary key, unique and index commands
+// of the blueprint, the name of the blueprint is the name of the type.
+func (r *Grammar) CompileCreateTableType(blueprint driver.Blueprint) string {
+	definitions := r.getColumns(blueprint)
+	for _, command := range blueprint.GetCommands() {
+		switch command.Name {
+		case schema.CommandPrimary:
+			definitions = append(definitions, fmt.Sprintf("primary key (%s)", r.wrap.Columnize(command.Columns)))
+		case schema.CommandUnique:
+			definitions = append(definitions, fmt.Sprintf("unique (%s)", r.wrap.Columnize(command.Columns)))
+		case schema.CommandIndex:
+			definitions = append(definitions, fmt.Sprintf("index %s (%s)", r.wrap.Column(command.Index), r.wrap.Columnize(command.Columns)))
+		}
+	}
+
+	return fmt.Sprintf("create type %s as table (%s)", r.wrapType(blueprint.GetTableName()), strings.Join(definitions, ", "))
+}
+
+// CompileCreateType Compile the alias type based on the system type, such as nvarchar(320) not null.
+func (r *Grammar) CompileCreateType(name, definition string) string {
+	return fmt.Sprintf("create type %s from %s", r.wrapType(name), definition)
 }
 
 func (r *Grammar) CompileDefault(blueprint driver.Blueprint, command *driver.Command) string {
 	if command.Column.IsChange() && command.Column.GetDefault() != nil {
 		return fmt.Sprintf("alter table %s add default %s for %s",
 			r.wrap.Table(blueprint.GetTableName()),
-			schema.ColumnDefaultValue(command.Column.GetDefault()),
+			r.getDefaultValue(command.Column.GetDefault()),
 			r.wrap.Column(command.Column.GetName()),
 		)
 	}
@@ -107,7 +259,12 @@ func (r *Grammar) CompileDefault(blueprint driver.Blueprint, command *driver.Com
 }
 
 func (r *Grammar) CompileDrop(blueprint driver.Blueprint) string {
-	return fmt.Sprintf("drop table %s", r.wrap.Table(blueprint.GetTableName()))
+	return r.compileDropTable(r.wrap.Table(blueprint.GetTableName()))
+}
+
+// CompileDisableSystemVersioning Compile the statement that turns the system versioning of the table off.
+func (r *Grammar) CompileDisableSystemVersioning(table string) string {
+	return fmt.Sprintf("alter table %s set (system_versioning = off)", r.wrap.Table(table))
 }
 
 func (r *Grammar) CompileDropAllDomains(_ []string) string {
@@ -124,15 +281,69 @@ func (r *Grammar) CompileDropAllForeignKeys() string {
             EXEC sp_executesql @sql;`
 }
 
-func (r *Grammar) CompileDropAllTables(_ string, _ []driver.Table) []string {
-	return []string{
-		r.CompileDropAllForeignKeys(),
-		"EXEC sp_msforeachtable 'DROP TABLE ?'",
+func (r *Grammar) CompileDropAllTables(schema string, tables []driver.Table) []string {
+	schemaFilter := ""
+	if schema != "" {
+		schemaFilter = fmt.Sprintf(" AND schema_id = SCHEMA_ID(%s)", quoteString(schema))
+	}
+
+	// The sequences are dropped after the tables, since the defaults of the columns can use them. The ones still used
+	// by the skipped tables are kept.
+	dropSequences := fmt.Sprintf(`
+DECLARE @sql NVARCHAR(MAX) = N'';
+SELECT @sql += N'DROP SEQUENCE ' + QUOTENAME(OBJECT_SCHEMA_NAME(object_id)) + N'.' + QUOTENAME(name) + N';'
+FROM sys.sequences WHERE object_id NOT IN (SELECT referenced_id FROM sys.sql_expression_dependencies WHERE referenced_id IS NOT NULL)%s;
+EXEC sp_executesql @sql;`, schemaFilter)
+
+	if len(tables) == 0 {
+		return []string{dropSequences}
+	}
+
+	objects := make([]string, len(tables))
+	for i, table := range tables {
+		name := r.wrap.Value(table.Name)
+		if table.Schema != "" {
+			name = r.wrap.Value(table.Schema) + "." + name
+		}
+		objects[i] = fmt.Sprintf("OBJECT_ID(%s)", quoteString(name))
+	}
+
+	// The memory-optimized and ledger tables are skipped with their history tables, the ledger_type column only exists
+	// since SQL Server 2022. The system versioning is turned off before the current and history tables are dropped. The
+	// temporary table is left in the pooled session when a statement fails, so it's dropped first.
+	return []string{fmt.Sprintf(`IF OBJECT_ID('tempdb..#drop_tables') IS NOT NULL DROP TABLE #drop_tables;
+CREATE TABLE #drop_tables (object_id INT PRIMARY KEY, history_table_id INT NULL);
+INSERT INTO #drop_tables SELECT object_id, history_table_id FROM sys.tables WHERE is_memory_optimized = 0 AND object_id IN (%s)%s;
+IF COL_LENGTH('sys.tables', 'ledger_type') IS NOT NULL
+    EXEC(N'DELETE FROM #drop_tables WHERE object_id IN (SELECT object_id FROM sys.tables WHERE ledger_type <> 0)');
+DELETE FROM #drop_tables WHERE object_id IN (SELECT history_table_id FROM sys.tables WHERE object_id NOT IN (SELECT object_id FROM #drop_tables));
+INSERT INTO #drop_tables SELECT history_table_id, NULL FROM #drop_tables WHERE history_table_id IS NOT NULL AND history_table_id NOT IN (SELECT object_id FROM #drop_tables);
+
+DECLARE @sql NVARCHAR(MAX) = N'';
+SELECT @sql += N'ALTER TABLE ' + QUOTENAME(OBJECT_SCHEMA_NAME(object_id)) + N'.' + QUOTENAME(OBJECT_NAME(object_id)) + N' SET (SYSTEM_VERSIONING = OFF);'
+FROM #drop_tables WHERE history_table_id IS NOT NULL;
+SELECT @sql += N'ALTER TABLE ' + QUOTENAME(OBJECT_SCHEMA_NAME(parent_object_id)) + N'.' + QUOTENAME(OBJECT_NAME(parent_object_id)) + N' DROP CONSTRAINT ' + QUOTENAME(name) + N';'
+FROM sys.foreign_keys WHERE parent_object_id IN (SELECT object_id FROM #drop_tables) OR referenced_object_id IN (SELECT object_id FROM #drop_tables);
+SELECT @sql += N'DROP TABLE ' + QUOTENAME(OBJECT_SCHEMA_NAME(object_id)) + N'.' + QUOTENAME(OBJECT_NAME(object_id)) + N';'
+FROM #drop_tables;
+
+DROP TABLE #drop_tables;
+EXEC sp_executesql @sql;`, strings.Join(objects, ", "), schemaFilter), dropSequences}
+}
+
+func (r *Grammar) CompileDropAllTypes(_ string, types []driver.Type) []string {
+	// The columns of the table types can use the alias types, so the table types are dropped first.
+	var tableTypes, aliasTypes []string
+	for _, t := range types {
+		sql := r.CompileDropType(t.Schema + "." + t.Name)
+		if t.Type == "table" {
+			tableTypes = append(tableTypes, sql)
+		} else {
+			aliasTypes = append(aliasTypes, sql)
+		}
 	}
-}
 
-func (r *Grammar) CompileDropAllTypes(_ string, _ []driver.Type) []string {
-	return nil
+	return append(tableTypes, aliasTypes...)
 }
 
 func (r *Grammar) CompileDropAllViews(_ string, _ []driver.View) []string {
@@ -174,17 +385,34 @@ func (r *Grammar) CompileDropForeign(blueprint driver.Blueprint, command *driver
 	return fmt.Sprintf("alter table %s drop constraint %s", r.wrap.Table(blueprint.GetTableName()), r.wrap.Column(command.Index))
 }
 
+// CompileDropFullText SQL Server can't drop the full-text index in a transaction, and the blueprint is always built in
+// one, so it's dropped by CompileDropFullTextIndex out of the blueprint.
 func (r *Grammar) CompileDropFullText(_ driver.Blueprint, _ *driver.Command) string {
 	return ""
 }
 
+// CompileDropFullTextIndex Compile the statement that drops the full-text index of the table, a table has one
+// full-text index at most.
+func (r *Grammar) CompileDropFullTextIndex(table string) string {
+	return fmt.Sprintf("drop fulltext index on %s", r.wrap.Table(table))
+}
+
 func (r *Grammar) CompileDropIfExists(blueprint driver.Blueprint) string {
 	table := r.wrap.Table(blueprint.GetTableName())
 
-	return fmt.Sprintf("if object_id(%s, 'U') is not null drop table %s", r.wrap.Quote(table), table)
+	return fmt.Sprintf("if object_id(%s, 'U') is not null begin %s end", r.wrap.Quote(table), r.compileDropTable(table))
 }
 
 func (r *Grammar) CompileDropIndex(blueprint driver.Blueprint, command *driver.Command) string {
+	if commandOptions(command).columnstoreDrop {
+		// A table has one columnstore index at most, the clustered or the nonclustered one.
+		table := r.wrap.Table(blueprint.GetTableName())
+
+		return fmt.Sprintf("declare @index sysname = (select name from sys.indexes where object_id = object_id(%s) and type in (5, 6)); "+
+			"if @index is not null exec(N'drop index ' + quotename(@index) + N' on %s')",
+			quoteString(table), strings.ReplaceAll(table, "'", "''"))
+	}
+
 	return fmt.Sprintf("drop index %s on %s", r.wrap.Column(command.Index), r.wrap.Table(blueprint.GetTableName()))
 }
 
@@ -192,10 +420,31 @@ func (r *Grammar) CompileDropPrimary(blueprint driver.Blueprint, command *driver
 	return fmt.Sprintf("alter table %s drop constraint %s", r.wrap.Table(blueprint.GetTableName()), r.wrap.Column(command.Index))
 }
 
+func (r *Grammar) CompileDropSequence(name string) string {
+	return fmt.Sprintf("drop sequence if exists %s", r.wrapType(name))
+}
+
+func (r *Grammar) CompileDropType(name string) string {
+	return fmt.Sprintf("drop type if exists %s", r.wrapType(name))
+}
+
 func (r *Grammar) CompileDropUnique(blueprint driver.Blueprint, command *driver.Command) string {
 	return r.CompileDropIndex(blueprint, command)
 }
 
+// CompileEnableSystemVersioning Compile the statements that add the period columns to the table if they don't exist,
+// and turn the system versioning of the table on.
+func (r *Grammar) CompileEnableSystemVersioning(table string, options SystemVersioning) []string {
+	options = r.withPeriodColumns(options)
+	wrappedTable := r.wrap.Table(table)
+
+	return []string{
+		fmt.Sprintf("if col_length(%s, %s) is null alter table %s add %s",
+			quoteString(wrappedTable), quoteString(options.ValidFrom), wrappedTable, r.compilePeriod(options)),
+		fmt.Sprintf("alter table %s set (%s)", wrappedTable, r.compileSystemVersioning(table, options)),
+	}
+}
+
 func (r *Grammar) CompileForeign(blueprint driver.Blueprint, command *driver.Command) string {
 	sql := fmt.Sprintf("alter table %s add constraint %s foreign key (%s) references %s (%s)",
 		r.wrap.Table(blueprint.GetTableName()),
@@ -214,10 +463,7 @@ func (r *Grammar) CompileForeign(blueprint driver.Blueprint, command *driver.Com
 }
 
 func (r *Grammar) CompileForeignKeys(schema, table string) string {
-	newSchema := "schema_name()"
-	if schema != "" {
-		newSchema = r.wrap.Quote(schema)
-	}
+	newSchema := r.compileSchema(r.defaultSchema(schema))
 
 	return fmt.Sprintf(
 		`SELECT 
@@ -243,33 +489,96 @@ func (r *Grammar) CompileForeignKeys(schema, table string) string {
 	)
 }
 
+// CompileFullText SQL Server can't create the full-text index in a transaction, and the blueprint is always built in
+// one, so it's created by CompileCreateFullTextIndex out of the blueprint.
 func (r *Grammar) CompileFullText(_ driver.Blueprint, _ *driver.Command) string {
 	return ""
 }
 
+// CompileFullTextContains Compile the CONTAINS predicate that matches the search condition on the full-text indexed
+// columns, * matches all of them. The search condition can use the terms built by FullTextPrefix and FullTextNear.
+func (r *Grammar) CompileFullTextContains(columns []string, search string, isNot bool) (string, []any) {
+	return r.compileFullTextPredicate("contains", columns, isNot), []any{search}
+}
+
+// CompileFullTextFreeText Compile the FREETEXT predicate that matches the meaning of the search text on the
+// full-text indexed columns, * matches all of them.
+func (r *Grammar) CompileFullTextFreeText(columns []string, search string, isNot bool) (string, []any) {
+	return r.compileFullTextPredicate("freetext", columns, isNot), []any{search}
+}
+
+// CompileFullTextRank Compile the join of CONTAINSTABLE, or FREETEXTTABLE when freeText is true, on the key column of
+// the table. The rank of the rows is "ft"."RANK", order by FullTextRank to get the best matches first.
+func (r *Grammar) CompileFullTextRank(table, key string, columns []string, search string, freeText bool) (string, []any) {
+	function := "containstable"
+	if freeText {
+		function = "freetexttable"
+	}
+
+	table = r.wrap.Table(table)
+	alias := r.wrap.Value(fullTextAlias)
+
+	return fmt.Sprintf("%s(%s, %s, ?) as %s on %s.%s = %s.%s",
+		function, table, r.compileFullTextColumns(columns), alias, table, r.wrap.Column(key), alias, r.wrap.Column("KEY")), []any{search}
+}
+
 func (r *Grammar) CompileIndex(blueprint driver.Blueprint, command *driver.Command) string {
-	return fmt.Sprintf("create index %s on %s (%s)",
-		r.wrap.Column(command.Index),
-		r.wrap.Table(blueprint.GetTableName()),
-		r.wrap.Columnize(command.Columns),
-	)
+	options := commandOptions(command)
+	if options.spatial != nil {
+		return r.compileSpatialIndex(blueprint, command, *options.spatial)
+	}
+	if options.columnstore != nil {
+		return r.compileColumnstoreIndex(blueprint, command, *options.columnstore)
+	}
+
+	return r.compileIndex(blueprint, command, false)
 }
 
-func (r *Grammar) CompileIndexes(_, table string) (string, error) {
-	schema, table, err := parseSchemaAndTable(table, "")
+// CompileIndexDetails Compile the query that lists the descending key columns, the included columns and the filter of
+// the indexes of the table, the details that CompileIndexes has no room for.
+func (r *Grammar) CompileIndexDetails(schema, table string) (string, error) {
+	schema, table, err := parseSchemaAndTable(table, r.defaultSchema(schema))
 	if err != nil {
 		return "", err
 	}
 
 	table = r.prefix + table
+	newSchema := r.compileSchema(schema)
 
-	newSchema := "schema_name()"
-	if schema != "" {
-		newSchema = r.wrap.Quote(schema)
+	return fmt.Sprintf(
+		"select idx.name as name, "+
+			"coalesce(string_agg(case when idxcol.is_included_column = 0 and idxcol.is_descending_key = 1 then col.name end, ',') "+
+			"within group (order by idxcol.key_ordinal, idxcol.index_column_id), '') as descending, "+
+			"coalesce(string_agg(case when idxcol.is_included_column = 1 and idx.type not in (5, 6) then col.name end, ',') "+
+			"within group (order by idxcol.key_ordinal, idxcol.index_column_id), '') as include, "+
+			"coalesce(idx.filter_definition, '') as [where] "+
+			"from sys.indexes as idx "+
+			"join sys.tables as tbl on idx.object_id = tbl.object_id "+
+			"join sys.schemas as scm on tbl.schema_id = scm.schema_id "+
+			"join sys.index_columns as idxcol on idx.object_id = idxcol.object_id and idx.index_id = idxcol.index_id "+
+			"join sys.columns as col on idxcol.object_id = col.object_id and idxcol.column_id = col.column_id "+
+			"where tbl.name = %s and scm.name = %s "+
+			"group by idx.name, idx.filter_definition",
+		r.wrap.Quote(table),
+		newSchema,
+	), nil
+}
+
+func (r *Grammar) CompileIndexes(schema, table string) (string, error) {
+	schema, table, err := parseSchemaAndTable(table, r.defaultSchema(schema))
+	if err != nil {
+		return "", err
 	}
 
+	table = r.prefix + table
+	newSchema := r.compileSchema(schema)
+
 	return fmt.Sprintf(
-		"select idx.name as name, string_agg(col.name, ',') within group (order by idxcol.key_ordinal) as columns, "+
+		// The included columns are the columns of the columnstore indexes, they are reported by CompileIndexDetails
+		// for the other indexes.
+		"select idx.name as name, "+
+			"string_agg(case when idxcol.is_included_column = 0 or idx.type in (5, 6) then col.name end, ',') "+
+			"within group (order by idxcol.key_ordinal, idxcol.index_column_id) as columns, "+
 			"idx.type_desc as [type], idx.is_unique as [unique], idx.is_primary_key as [primary] "+
 			"from sys.indexes as idx "+
 			"join sys.tables as tbl on idx.object_id = tbl.object_id "+
@@ -277,7 +586,21 @@ func (r *Grammar) CompileIndexes(_, table string) (string, error) {
 			"join sys.index_columns as idxcol on idx.object_id = idxcol.object_id and idx.index_id = idxcol.index_id "+
 			"join sys.columns as col on idxcol.object_id = col.object_id and idxcol.column_id = col.column_id "+
 			"where tbl.name = %s and scm.name = %s "+
-			"group by idx.name, idx.type_desc, idx.is_unique, idx.is_primary_key",
+			"group by idx.name, idx.type_desc, idx.is_unique, idx.is_primary_key "+
+			"union all "+
+			// A table has one full-text index at most, it's named the same as the index created by FullText.
+			"select tbl.name + '_' + string_agg(col.name, '_') within group (order by col.column_id) + '_fulltext' as name, "+
+			"string_agg(col.name, ',') within group (order by col.column_id) as columns, "+
+			"'fulltext' as [type], cast(0 as bit) as [unique], cast(0 as bit) as [primary] "+
+			"from sys.fulltext_indexes as idx "+
+			"join sys.tables as tbl on idx.object_id = tbl.object_id "+
+			"join sys.schemas as scm on tbl.schema_id = scm.schema_id "+
+			"join sys.fulltext_index_columns as idxcol on idx.object_id = idxcol.object_id "+
+			"join sys.columns as col on idxcol.object_id = col.object_id and idxcol.column_id = col.column_id "+
+			"where tbl.name = %s and scm.name = %s "+
+			"group by tbl.name",
+		r.wrap.Quote(table),
+		newSchema,
 		r.wrap.Quote(table),
 		newSchema,
 	), nil
@@ -401,6 +724,11 @@ func (r *Grammar) CompileLockForUpdateForGorm() clause.Expression {
 	return With("ROWLOCK", "UPDLOCK", "HOLDLOCK")
 }
 
+// CompileNextValue Compile the query that gets the next value of the sequence.
+func (r *Grammar) CompileNextValue(sequence string) string {
+	return fmt.Sprintf("select next value for %s as value", r.wrapType(sequence))
+}
+
 func (r *Grammar) CompileOffset(builder sq.SelectBuilder, conditions *driver.Conditions) sq.SelectBuilder {
 	if conditions.Offset == nil && conditions.Limit != nil {
 		conditions.Offset = convert.Pointer[uint64](0)
@@ -425,10 +753,21 @@ func (r *Grammar) CompilePlaceholderFormat() driver.PlaceholderFormat {
 }
 
 func (r *Grammar) CompilePrimary(blueprint driver.Blueprint, command *driver.Command) string {
-	return fmt.Sprintf("alter table %s add constraint %s primary key (%s)",
+	options := r.getIndex(command)
+
+	var clustered string
+	if options.Clustered {
+		clustered = " clustered"
+	} else if options.NonClustered {
+		clustered = " nonclustered"
+	}
+
+	return fmt.Sprintf("alter table %s add constraint %s primary key%s (%s)%s",
 		r.wrap.Table(blueprint.GetTableName()),
 		r.wrap.Column(command.Index),
-		r.wrap.Columnize(command.Columns))
+		clustered,
+		r.compileIndexColumns(command.Columns, options.Descending),
+		r.compileIndexStorage(options))
 }
 
 func (r *Grammar) CompilePrune(database string) string {
@@ -447,8 +786,20 @@ func (r *Grammar) CompileRandomOrderForGorm() string {
 	return "NEWID()"
 }
 
+// CompileReseed Compile the DBCC CHECKIDENT command that sets the current identity value of the table, the next row
+// gets the value plus the increment. The current value is corrected to the max value in the column when value is nil.
+func (r *Grammar) CompileReseed(table string, value *int64) string {
+	table = quoteString(r.wrap.Table(table))
+	if value == nil {
+		return fmt.Sprintf("dbcc checkident (%s, reseed)", table)
+	}
+
+	return fmt.Sprintf("dbcc checkident (%s, reseed, %d)", table, *value)
+}
+
 func (r *Grammar) CompileRename(blueprint driver.Blueprint, command *driver.Command) string {
-	return fmt.Sprintf("sp_rename %s, %s", r.wrap.Quote(r.wrap.Table(blueprint.GetTableName())), r.wrap.Table(command.To))
+	// The new name of sp_rename can't be qualified by the schema.
+	return fmt.Sprintf("sp_rename %s, %s", r.wrap.Quote(r.wrap.Table(blueprint.GetTableName())), r.wrap.Wrap.Table(command.To))
 }
 
 func (r *Grammar) CompileRenameColumn(blueprint driver.Blueprint, command *driver.Command, _ []driver.Column) (string, error) {
@@ -464,6 +815,27 @@ func (r *Grammar) CompileRenameIndex(blueprint driver.Blueprint, command *driver
 	}
 }
 
+// CompileSequenceRange Compile the query that reserves the next size values of the sequence with
+// sp_sequence_get_range, and gets the first one.
+func (r *Grammar) CompileSequenceRange(sequence string, size int64) string {
+	return fmt.Sprintf("declare @first sql_variant; "+
+		"exec sp_sequence_get_range @sequence_name = %s, @range_size = %d, @range_first_value = @first output; "+
+		"select cast(@first as bigint) as value", quoteString(r.wrapType(sequence)), size)
+}
+
+// CompileSequences Compile the query that lists the sequences, the cache is -1 when they aren't cached, and 0 when
+// SQL Server decides the cache size.
+func (r *Grammar) CompileSequences() string {
+	return "select s.name as name, schema_name(s.schema_id) as [schema], type_name(s.user_type_id) as [type], " +
+		"cast(s.start_value as bigint) as start, cast(s.increment as bigint) as increment, " +
+		"cast(s.minimum_value as bigint) as min_value, cast(s.maximum_value as bigint) as max_value, " +
+		"s.is_cycling as cycle, case when s.is_cached = 0 then -1 else coalesce(s.cache_size, 0) end as cache, " +
+		"cast(s.current_value as bigint) as current_value " +
+		"from sys.sequences as s " +
+		r.compileSchemaFilter("s") +
+		"order by s.name"
+}
+
 func (r *Grammar) CompileSharedLock(builder sq.SelectBuilder, conditions *driver.Conditions) sq.SelectBuilder {
 	if conditions.LockForUpdate != nil && *conditions.LockForUpdate {
 		builder = builder.From(conditions.Table + " WITH (ROWLOCK, HOLDLOCK)")
@@ -476,28 +848,82 @@ func (r *Grammar) CompileSharedLockForGorm() clause.Expression {
 	return With("ROWLOCK", "HOLDLOCK")
 }
 
+// CompileSpatialContains Compile the condition that the geometry or geography column contains the shape.
+func (r *Grammar) CompileSpatialContains(column string, shape Shape, isNot bool) (string, []any) {
+	return r.compileSpatialPredicate("STContains", column, shape, isNot)
+}
+
+// CompileSpatialDistance Compile the distance between the column and the shape, it's in the unit of the SRID, such
+// as meters for 4326. It can be selected or ordered by.
+func (r *Grammar) CompileSpatialDistance(column string, shape Shape) (string, []any) {
+	return fmt.Sprintf("%s.STDistance(%s)", r.wrap.Column(column), shape.sql), shape.args
+}
+
+// CompileSpatialDistanceWithin Compile the condition that the column is within the distance of the shape.
+func (r *Grammar) CompileSpatialDistanceWithin(column string, shape Shape, distance float64) (string, []any) {
+	sql, args := r.CompileSpatialDistance(column, shape)
+
+	return sql + " <= ?", append(args, distance)
+}
+
+// CompileSpatialIntersects Compile the condition that the geometry or geography column intersects the shape.
+func (r *Grammar) CompileSpatialIntersects(column string, shape Shape, isNot bool) (string, []any) {
+	return r.compileSpatialPredicate("STIntersects", column, shape, isNot)
+}
+
 func (r *Grammar) CompileTables(_ string) string {
-	return "select t.name as name, schema_name(t.schema_id) as [schema], sum(u.total_pages) * 8 * 1024 as size " +
+	return "select t.name as name, schema_name(t.schema_id) as [schema], sum(u.total_pages) * 8 * 1024 as size, " +
+		"max(cast(prop.value as nvarchar(4000))) as comment " +
 		"from sys.tables as t " +
 		"join sys.partitions as p on p.object_id = t.object_id " +
 		"join sys.allocation_units as u on u.container_id = p.hobt_id " +
+		"left join sys.extended_properties as prop on prop.class = 1 and t.object_id = prop.major_id and prop.minor_id = 0 and prop.name = 'MS_Description' " +
+		r.compileSchemaFilter("t") +
 		"group by t.name, t.schema_id " +
 		"order by t.name"
 }
 
-func (r *Grammar) CompileTableComment(_ driver.Blueprint, _ *driver.Command) string {
-	return ""
+func (r *Grammar) CompileTableComment(blueprint driver.Blueprint, command *driver.Command) string {
+	return r.compileDescription(blueprint, "", cast.ToString(command.Value))
 }
 
 func (r *Grammar) CompileTypes() string {
-	return ""
+	return "select t.name as name, schema_name(t.schema_id) as [schema], " +
+		"case when t.is_table_type = 1 then 'table' else 'alias' end as [type], " +
+		"case when t.is_table_type = 1 then 'table' else type_name(t.system_type_id) end as category, " +
+		"cast(0 as bit) as implicit " +
+		"from (select * from sys.types where is_user_defined = 1) as t " +
+		r.compileSchemaFilter("t") +
+		"order by t.name"
 }
 
 func (r *Grammar) CompileUnique(blueprint driver.Blueprint, command *driver.Command) string {
-	return fmt.Sprintf("create unique index %s on %s (%s)",
-		r.wrap.Column(command.Index),
-		r.wrap.Table(blueprint.GetTableName()),
-		r.wrap.Columnize(command.Columns))
+	return r.compileIndex(blueprint, command, true)
+}
+
+// CompileUpdateWithRowVersion Compile the update of the row that still has the row version, and the select of the new
+// row version. The new row version is output into a table variable, the output without into fails on the tables that
+// have triggers. The table is the one of the model, the prefix is already added to it, so it's only qualified by the
+// default schema. The values are bound first, then the key and the row version.
+func (r *Grammar) CompileUpdateWithRowVersion(table, key, column string, values map[string]any) (string, []any) {
+	var (
+		sets []string
+		args []any
+	)
+	for _, name := range slices.Sorted(maps.Keys(values)) {
+		sets = append(sets, fmt.Sprintf("%s = ?", r.wrap.Column(name)))
+		args = append(args, values[name])
+	}
+
+	return fmt.Sprintf("declare @versions table (version binary(8)); "+
+		"update %s set %s output inserted.%s into @versions where %s = ? and %s = ?; "+
+		"select version from @versions",
+		NewWrapWithSchema("", r.schema).Table(table),
+		strings.Join(sets, ", "),
+		r.wrap.Column(column),
+		r.wrap.Column(key),
+		r.wrap.Column(column),
+	), args
 }
 
 func (r *Grammar) CompileVersion() string {
@@ -507,6 +933,7 @@ func (r *Grammar) CompileVersion() string {
 func (r *Grammar) CompileViews(_ string) string {
 	return "select name, schema_name(v.schema_id) as [schema], definition from sys.views as v " +
 		"inner join sys.sql_modules as m on v.object_id = m.object_id " +
+		r.compileSchemaFilter("v") +
 		"order by name"
 }
 
@@ -514,14 +941,32 @@ func (r *Grammar) GetAttributeCommands() []string {
 	return r.attributeCommands
 }
 
+func (r *Grammar) ModifyCollate(blueprint driver.Blueprint, column driver.ColumnDefinition) string {
+	if collation := r.getCollation(blueprint, column); collation != "" {
+		return " collate " + collation
+	}
+
+	return ""
+}
+
 func (r *Grammar) ModifyDefault(_ driver.Blueprint, column driver.ColumnDefinition) string {
 	if !column.IsChange() && column.GetDefault() != nil {
-		return fmt.Sprintf(" default %s", schema.ColumnDefaultValue(column.GetDefault()))
+		return fmt.Sprintf(" default %s", r.getDefaultValue(column.GetDefault()))
 	}
 
 	return ""
 }
 
+// ModifySrid Check the SRID of the values of the column set by Geometry or Geography.
+func (r *Grammar) ModifySrid(blueprint driver.Blueprint, column driver.ColumnDefinition) string {
+	srid := columnOptions(column).srid
+	if srid == nil || column.IsChange() {
+		return ""
+	}
+
+	return fmt.Sprintf(" check (%s.STSrid = %d)", r.wrap.Column(column.GetName()), *srid)
+}
+
 func (r *Grammar) ModifyNullable(_ driver.Blueprint, column driver.ColumnDefinition) string {
 	if column.GetNullable() {
 		return " null"
@@ -531,14 +976,26 @@ func (r *Grammar) ModifyNullable(_ driver.Blueprint, column driver.ColumnDefinit
 }
 
 func (r *Grammar) ModifyIncrement(blueprint driver.Blueprint, column driver.ColumnDefinition) string {
-	if !column.IsChange() && slices.Contains(r.serials, column.GetType()) && column.GetAutoIncrement() {
-		if blueprint.HasCommand("primary") {
-			return " identity"
-		}
-		return " identity primary key"
+	if column.IsChange() || !slices.Contains(r.serials, column.GetType()) {
+		return ""
 	}
 
-	return ""
+	// An identity column set by Identity isn't the primary key unless it's auto increment.
+	identity := columnOptions(column).identity
+	autoIncrement := column.GetAutoIncrement()
+	if identity == nil && !autoIncrement {
+		return ""
+	}
+
+	sql := " identity"
+	if identity != nil {
+		sql = fmt.Sprintf(" identity(%d, %d)", identity.seed, identity.increment)
+	}
+	if !autoIncrement || blueprint.HasCommand("primary") {
+		return sql
+	}
+
+	return sql + " primary key"
 }
 
 func (r *Grammar) TypeBigInteger(_ driver.ColumnDefinition) string {
@@ -586,6 +1043,14 @@ func (r *Grammar) TypeFloat(column driver.ColumnDefinition) string {
 	return "float"
 }
 
+func (r *Grammar) TypeGeography(_ driver.ColumnDefinition) string {
+	return "geography"
+}
+
+func (r *Grammar) TypeGeometry(_ driver.ColumnDefinition) string {
+	return "geometry"
+}
+
 func (r *Grammar) TypeInteger(_ driver.ColumnDefinition) string {
 	return "int"
 }
@@ -610,6 +1075,10 @@ func (r *Grammar) TypeMediumText(_ driver.ColumnDefinition) string {
 	return "nvarchar(max)"
 }
 
+func (r *Grammar) TypeRowVersion(_ driver.ColumnDefinition) string {
+	return "rowversion"
+}
+
 func (r *Grammar) TypeSmallInteger(_ driver.ColumnDefinition) string {
 	return "smallint"
 }
@@ -675,6 +1144,28 @@ func (r *Grammar) TypeUuid(_ driver.ColumnDefinition) string {
 	return "uniqueidentifier"
 }
 
+func (r *Grammar) compileColumnstoreIndex(blueprint driver.Blueprint, command *driver.Command, options ColumnstoreIndex) string {
+	table := r.wrap.Table(blueprint.GetTableName())
+
+	var sql string
+	if options.Clustered {
+		sql = fmt.Sprintf("create clustered columnstore index %s on %s", r.wrap.Column(command.Index), table)
+	} else {
+		sql = fmt.Sprintf("create nonclustered columnstore index %s on %s (%s)", r.wrap.Column(command.Index), table, r.wrap.Columnize(command.Columns))
+	}
+	if len(options.Order) > 0 {
+		sql += fmt.Sprintf(" order (%s)", r.wrap.Columnize(options.Order))
+	}
+	if options.Where != "" && !options.Clustered {
+		sql += " where " + options.Where
+	}
+	if options.Archive {
+		sql += " with (data_compression = columnstore_archive)"
+	}
+
+	return sql
+}
+
 func (r *Grammar) compileDecimalCastExpr(value float64) (string, string) {
 	param := strconv.FormatFloat(value, 'f', -1, 64)
 	parts := strings.Split(param, ".")
@@ -693,6 +1184,312 @@ func (r *Grammar) compileDecimalCastExpr(value float64) (string, string) {
 	return fmt.Sprintf("cast(? as decimal(%d,%d))", precision, decLen), param
 }
 
+// compileDescription Compile the statement that sets the MS_Description extended property of the table, or of the
+// column when it isn't empty. The property is dropped when the comment is empty.
+func (r *Grammar) compileDescription(blueprint driver.Blueprint, column, comment string) string {
+	schema, table, err := parseSchemaAndTable(blueprint.GetTableName(), r.schema)
+	if err != nil {
+		return ""
+	}
+
+	level := fmt.Sprintf("N'SCHEMA', @schema, N'TABLE', %s", quoteString(r.prefix+table))
+	if column != "" {
+		level += fmt.Sprintf(", N'COLUMN', %s", quoteString(column))
+	}
+
+	filter := level
+	if column == "" {
+		filter += ", null, null"
+	}
+
+	sql := fmt.Sprintf("declare @schema sysname = %s; "+
+		"if exists (select * from sys.fn_listextendedproperty(N'MS_Description', %s)) ", r.compileSchema(schema), filter)
+	if comment == "" {
+		return sql + fmt.Sprintf("exec sp_dropextendedproperty N'MS_Description', %s", level)
+	}
+
+	value := quoteString(comment)
+
+	return sql + fmt.Sprintf("exec sp_updateextendedproperty N'MS_Description', %s, %s "+
+		"else exec sp_addextendedproperty N'MS_Description', %s, %s", value, level, value, level)
+}
+
+// compileDropTable Compile the statement that drops the table, the system versioning of a temporal table is turned
+// off, and its history table is dropped too.
+func (r *Grammar) compileDropTable(table string) string {
+	return fmt.Sprintf("declare @history nvarchar(max) = (select quotename(schema_name(h.schema_id)) + N'.' + quotename(h.name) "+
+		"from sys.tables as t join sys.tables as h on t.history_table_id = h.object_id where t.object_id = object_id(%s) and t.temporal_type = 2); "+
+		"if @history is not null alter table %s set (system_versioning = off); "+
+		"drop table %s; "+
+		"if @history is not null exec (N'drop table ' + @history)", quoteString(table), table, table)
+}
+
+func (r *Grammar) compileFullTextColumns(columns []string) string {
+	if len(columns) == 1 {
+		return r.wrap.Column(columns[0])
+	}
+
+	return "(" + r.wrap.Columnize(columns) + ")"
+}
+
+func (r *Grammar) compileFullTextPredicate(function string, columns []string, isNot bool) string {
+	sql := fmt.Sprintf("%s(%s, ?)", function, r.compileFullTextColumns(columns))
+	if isNot {
+		return "not " + sql
+	}
+
+	return sql
+}
+
+// compileIndex Compile the index or the unique index with the SQL Server options of it, the unique index skips the
+// nulls of its nullable columns when the nulls are distinct.
+func (r *Grammar) compileIndex(blueprint driver.Blueprint, command *driver.Command, unique bool) string {
+	options := r.getIndex(command)
+
+	sql := "create "
+	if unique {
+		sql += "unique "
+	}
+	if options.Clustered {
+		sql += "clustered "
+	} else if options.NonClustered {
+		sql += "nonclustered "
+	}
+
+	sql += fmt.Sprintf("index %s on %s (%s)",
+		r.wrap.Column(command.Index),
+		r.wrap.Table(blueprint.GetTableName()),
+		r.compileIndexColumns(command.Columns, options.Descending),
+	)
+	if len(options.Include) > 0 {
+		sql += fmt.Sprintf(" include (%s)", r.wrap.Columnize(options.Include))
+	}
+
+	var filters []string
+	if options.Where != "" {
+		filters = append(filters, options.Where)
+	}
+	if unique {
+		filters = append(filters, r.compileNotNullFilters(blueprint, command, options)...)
+	}
+	if len(filters) > 0 {
+		// The predicate of the filtered index can't have parentheses or OR, so the filters are joined by AND.
+		sql += " where " + strings.Join(filters, " and ")
+	}
+
+	return sql + r.compileIndexStorage(options)
+}
+
+func (r *Grammar) compileIndexColumns(columns, descending []string) string {
+	wrapped := r.wrap.Columns(columns)
+	for i, column := range columns {
+		if slices.Contains(descending, column) {
+			wrapped[i] += " desc"
+		}
+	}
+
+	return strings.Join(wrapped, ", ")
+}
+
+func (r *Grammar) compileIndexStorage(options Index) string {
+	var with []string
+	if options.PadIndex {
+		with = append(with, "pad_index = on")
+	}
+	if options.FillFactor > 0 {
+		with = append(with, fmt.Sprintf("fillfactor = %d", options.FillFactor))
+	}
+	if options.DataCompression != "" {
+		with = append(with, "data_compression = "+strings.ToLower(options.DataCompression))
+	}
+	if options.Online {
+		with = append(with, "online = on")
+	}
+
+	var sql string
+	if len(with) > 0 {
+		sql = fmt.Sprintf(" with (%s)", strings.Join(with, ", "))
+	}
+	if options.FileGroup != "" {
+		sql += " on " + r.wrap.Value(options.FileGroup)
+	}
+
+	return sql
+}
+
+// compileNotNullFilters Compile the filters that exclude the nulls of the nullable columns from the unique index, so
+// the columns can have multiple nulls. The columns that aren't added by the blueprint are treated as nullable.
+func (r *Grammar) compileNotNullFilters(blueprint driver.Blueprint, command *driver.Command, options Index) []string {
+	nullsDistinct := r.uniqueNullsDistinct
+	if options.NullsDistinct != nil {
+		nullsDistinct = *options.NullsDistinct
+	}
+	if !nullsDistinct {
+		return nil
+	}
+
+	var filters []string
+	for _, column := range command.Columns {
+		index := slices.IndexFunc(blueprint.GetAddedColumns(), func(added driver.ColumnDefinition) bool {
+			return added.GetName() == column
+		})
+		if index >= 0 && !blueprint.GetAddedColumns()[index].GetNullable() {
+			continue
+		}
+
+		filters = append(filters, r.wrap.Column(column)+" is not null")
+	}
+
+	return filters
+}
+
+// compilePeriod Compile the period columns and the period of the temporal table, the defaults fill the existing rows
+// when they are added to a table.
+func (r *Grammar) compilePeriod(options SystemVersioning) string {
+	options = r.withPeriodColumns(options)
+	hidden := ""
+	if options.Hidden {
+		hidden = " hidden"
+	}
+
+	validFrom := r.wrap.Column(options.ValidFrom)
+	validTo := r.wrap.Column(options.ValidTo)
+
+	return fmt.Sprintf("%s datetime2 generated always as row start%s not null default sysutcdatetime(), "+
+		"%s datetime2 generated always as row end%s not null default convert(datetime2, '9999-12-31 23:59:59.9999999'), "+
+		"period for system_time (%s, %s)", validFrom, hidden, validTo, hidden, validFrom, validTo)
+}
+
+// compileSequenceOptions Compile the options of the sequence, the cycle is turned off explicitly when it's altered.
+func (r *Grammar) compileSequenceOptions(sequence Sequence, alter bool) string {
+	var sql string
+	if sequence.Increment != 0 {
+		sql += fmt.Sprintf(" increment by %d", sequence.Increment)
+	}
+	if sequence.MinValue != nil {
+		sql += fmt.Sprintf(" minvalue %d", *sequence.MinValue)
+	}
+	if sequence.MaxValue != nil {
+		sql += fmt.Sprintf(" maxvalue %d", *sequence.MaxValue)
+	}
+	if sequence.Cycle {
+		sql += " cycle"
+	} else if alter {
+		sql += " no cycle"
+	}
+	if sequence.Cache > 0 {
+		sql += fmt.Sprintf(" cache %d", sequence.Cache)
+	} else if sequence.Cache < 0 {
+		sql += " no cache"
+	}
+
+	return sql
+}
+
+func (r *Grammar) compileSpatialIndex(blueprint driver.Blueprint, command *driver.Command, options SpatialIndex) string {
+	sql := fmt.Sprintf("create spatial index %s on %s (%s)",
+		r.wrap.Column(command.Index),
+		r.wrap.Table(blueprint.GetTableName()),
+		r.wrap.Column(command.Columns[0]),
+	)
+	if options.Tessellation != "" {
+		sql += " using " + strings.ToLower(options.Tessellation)
+	}
+
+	var with []string
+	if len(options.BoundingBox) > 0 {
+		bounds := make([]string, len(options.BoundingBox))
+		for i, bound := range options.BoundingBox {
+			bounds[i] = strconv.FormatFloat(bound, 'f', -1, 64)
+		}
+		with = append(with, fmt.Sprintf("bounding_box = (%s)", strings.Join(bounds, ", ")))
+	}
+	if len(options.Grids) > 0 {
+		grids := make([]string, len(options.Grids))
+		for i, grid := range options.Grids {
+			if grid == "" {
+				grid = "medium"
+			}
+			grids[i] = fmt.Sprintf("level_%d = %s", i+1, strings.ToLower(grid))
+		}
+		with = append(with, fmt.Sprintf("grids = (%s)", strings.Join(grids, ", ")))
+	}
+	if options.CellsPerObject > 0 {
+		with = append(with, fmt.Sprintf("cells_per_object = %d", options.CellsPerObject))
+	}
+	if len(with) > 0 {
+		sql += fmt.Sprintf(" with (%s)", strings.Join(with, ", "))
+	}
+
+	return sql
+}
+
+func (r *Grammar) compileSpatialPredicate(method, column string, shape Shape, isNot bool) (string, []any) {
+	result := 1
+	if isNot {
+		result = 0
+	}
+
+	return fmt.Sprintf("%s.%s(%s) = %d", r.wrap.Column(column), method, shape.sql, result), shape.args
+}
+
+// compileSystemVersioning Compile the system versioning option of the temporal table, the history table must be
+// qualified by the schema.
+func (r *Grammar) compileSystemVersioning(table string, options SystemVersioning) string {
+	tableSchema, tableName, _ := parseSchemaAndTable(table, r.schema)
+	if tableSchema == "" {
+		tableSchema = "dbo"
+	}
+
+	historyTable := options.HistoryTable
+	if historyTable == "" {
+		historyTable = tableName + "_history"
+	}
+	historySchema, historyName, _ := parseSchemaAndTable(historyTable, tableSchema)
+
+	sql := fmt.Sprintf("system_versioning = on (history_table = %s.%s", r.wrap.Value(historySchema), r.wrap.Value(r.prefix+historyName))
+	if options.RetentionPeriod != "" {
+		sql += ", history_retention_period = " + strings.ToUpper(options.RetentionPeriod)
+	}
+
+	return sql + ")"
+}
+
+// compileSchema Compile the schema in the introspection queries, the default schema of the user is used when empty.
+func (r *Grammar) compileSchema(schema string) string {
+	if schema == "" {
+		return "schema_name()"
+	}
+
+	return r.wrap.Quote(schema)
+}
+
+// compileSchemaFilter Compile the where clause that limits the tables or views to the default schema of the connection.
+func (r *Grammar) compileSchemaFilter(alias string) string {
+	if r.schema == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("where %s.schema_id = schema_id(%s) ", alias, r.wrap.Quote(r.schema))
+}
+
+func (r *Grammar) defaultSchema(schema string) string {
+	if schema == "" {
+		return r.schema
+	}
+
+	return schema
+}
+
+// getIndex Get the SQL Server options of the index set by TableIndex, the zero options when there are none.
+func (r *Grammar) getIndex(command *driver.Command) Index {
+	if index := commandOptions(command).index; index != nil {
+		return *index
+	}
+
+	return Index{}
+}
+
 func (r *Grammar) getColumns(blueprint driver.Blueprint) []string {
 	var columns []string
 	for _, column := range blueprint.GetAddedColumns() {
@@ -702,8 +1499,44 @@ func (r *Grammar) getColumns(blueprint driver.Blueprint) []string {
 	return columns
 }
 
+func (r *Grammar) getCollation(blueprint driver.Blueprint, column driver.ColumnDefinition) string {
+	if collation := columnOptions(column).collation; collation != "" {
+		return collation
+	}
+
+	collation := tableOptions(blueprint).collation
+	if collation == "" {
+		collation = r.collation
+	}
+	if collation == "" || !slices.Contains(r.collatables, column.GetType()) {
+		return ""
+	}
+
+	return collation
+}
+
 func (r *Grammar) getColumn(blueprint driver.Blueprint, column driver.ColumnDefinition) string {
-	sql := fmt.Sprintf("%s %s", r.wrap.Column(column.GetName()), schema.ColumnType(r, column))
+	if computed := columnOptions(column).computed; computed != nil {
+		sql := fmt.Sprintf("%s as (%s)", r.wrap.Column(column.GetName()), computed.expression)
+		if computed.persisted {
+			sql += " persisted"
+			if !column.GetNullable() {
+				sql += " not null"
+			}
+		}
+
+		return sql
+	}
+
+	columnType := schema.ColumnType(r, column)
+	if isUTF8Collation(r.getCollation(blueprint, column)) {
+		// A UTF-8 collation stores Unicode in char and varchar, the length of them is in bytes.
+		if strings.HasPrefix(columnType, "nchar") || strings.HasPrefix(columnType, "nvarchar") {
+			columnType = columnType[1:]
+		}
+	}
+
+	sql := fmt.Sprintf("%s %s", r.wrap.Column(column.GetName()), columnType)
 
 	for _, modifier := range r.modifiers {
 		sql += modifier(blueprint, column)
@@ -712,6 +1545,50 @@ func (r *Grammar) getColumn(blueprint driver.Blueprint, column driver.ColumnDefi
 	return sql
 }
 
+// getDefaultValue Get the default of the column, the sequence of NextValueFor is qualified by the default schema.
+func (r *Grammar) getDefaultValue(value any) string {
+	if sequence, ok := value.(SequenceDefault); ok {
+		return "next value for " + r.wrapType(string(sequence))
+	}
+
+	return schema.ColumnDefaultValue(value)
+}
+
+// quoteString Quote the value as a Unicode string literal.
+func quoteString(value string) string {
+	return "N'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+func (r *Grammar) withPeriodColumns(options SystemVersioning) SystemVersioning {
+	if options.ValidFrom == "" {
+		options.ValidFrom = defaultValidFrom
+	}
+	if options.ValidTo == "" {
+		options.ValidTo = defaultValidTo
+	}
+
+	return options
+}
+
+// wrapType Wrap the name of the user-defined type, and qualify it with the default schema when it isn't qualified. The
+// prefix of the tables isn't added to the types.
+func (r *Grammar) wrapType(name string) string {
+	schema, name, _ := parseSchemaAndTable(name, r.schema)
+	if schema == "" {
+		return r.wrap.Value(name)
+	}
+
+	return r.wrap.Value(schema) + "." + r.wrap.Value(name)
+}
+
+func (r *Grammar) wrapSequence(sequence Sequence) string {
+	if sequence.Schema != "" {
+		return r.wrapType(sequence.Schema + "." + sequence.Name)
+	}
+
+	return r.wrapType(sequence.Name)
+}
+
 func parseSchemaAndTable(reference, defaultSchema string) (string, string, error) {
 	if reference == "" {
 		return "", "", errors.SchemaEmptyReferenceString
@@ -1,6 +1,7 @@
 package sqlserver
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/goravel/framework/contracts/database/driver"
@@ -10,8 +11,11 @@ import (
 	"github.com/goravel/framework/foundation/json"
 	mocksdriver "github.com/goravel/framework/mocks/database/driver"
 	mocksfoundation "github.com/goravel/framework/mocks/foundation"
+	"github.com/goravel/framework/support/convert"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+
+	"github.com/goravel/sqlserver/contracts"
 )
 
 type GrammarSuite struct {
@@ -67,6 +71,20 @@ func (s *GrammarSuite) TestCompileChange() {
 	}, sql)
 }
 
+func (s *GrammarSuite) TestComputedColumns() {
+	blueprint := schema.NewBlueprint(nil, "", "orders")
+	VirtualAs(blueprint.String("label"), "[code] + '-' + [name]")
+	StoredAs(blueprint.Decimal("total"), "[price] * [quantity]").Change()
+
+	commands := blueprint.GetCommands()
+	s.Equal(`alter table "goravel_orders" add "label" as ([code] + '-' + [name])`,
+		s.grammar.CompileAdd(blueprint, commands[0]))
+	s.Equal([]string{
+		`alter table "goravel_orders" drop column "total"`,
+		`alter table "goravel_orders" add "total" as ([price] * [quantity]) persisted not null`,
+	}, s.grammar.CompileChange(blueprint, commands[1]))
+}
+
 func (s *GrammarSuite) TestCompileColumns() {
 	tests := []struct {
 		name          string
@@ -81,7 +99,10 @@ func (s *GrammarSuite) TestCompileColumns() {
 				`col.max_length as length, col.precision as precision, col.scale as places, ` +
 				`col.is_nullable as nullable, def.definition as [default], ` +
 				`col.is_identity as autoincrement, col.collation_name as collation, ` +
-				`com.definition as [expression], is_persisted as [persisted], ` +
+				`case when com.definition is not null then 'as ' + com.definition + case when com.is_persisted = 1 then ' persisted' else '' end ` +
+				`when idc.column_id is not null then 'identity(' + cast(idc.seed_value as varchar(40)) + ', ' + cast(idc.increment_value as varchar(40)) + ')' ` +
+				`+ coalesce(' last_value ' + cast(idc.last_value as varchar(40)), '') ` +
+				`else '' end as extra, ` +
 				`cast(prop.value as nvarchar(max)) as comment ` +
 				`from sys.columns as col ` +
 				`join sys.types as type on col.user_type_id = type.user_type_id ` +
@@ -90,6 +111,7 @@ func (s *GrammarSuite) TestCompileColumns() {
 				`left join sys.default_constraints def on col.default_object_id = def.object_id and col.object_id = def.parent_object_id ` +
 				`left join sys.extended_properties as prop on obj.object_id = prop.major_id and col.column_id = prop.minor_id and prop.name = 'MS_Description' ` +
 				`left join sys.computed_columns as com on col.column_id = com.column_id and col.object_id = com.object_id ` +
+				`left join sys.identity_columns as idc on col.column_id = idc.column_id and col.object_id = idc.object_id ` +
 				`where obj.type in ('U', 'V') and obj.name = 'goravel_users' and scm.name = schema_name() ` +
 				`order by col.column_id`,
 			expectedError: nil,
@@ -101,7 +123,10 @@ func (s *GrammarSuite) TestCompileColumns() {
 				`col.max_length as length, col.precision as precision, col.scale as places, ` +
 				`col.is_nullable as nullable, def.definition as [default], ` +
 				`col.is_identity as autoincrement, col.collation_name as collation, ` +
-				`com.definition as [expression], is_persisted as [persisted], ` +
+				`case when com.definition is not null then 'as ' + com.definition + case when com.is_persisted = 1 then ' persisted' else '' end ` +
+				`when idc.column_id is not null then 'identity(' + cast(idc.seed_value as varchar(40)) + ', ' + cast(idc.increment_value as varchar(40)) + ')' ` +
+				`+ coalesce(' last_value ' + cast(idc.last_value as varchar(40)), '') ` +
+				`else '' end as extra, ` +
 				`cast(prop.value as nvarchar(max)) as comment ` +
 				`from sys.columns as col ` +
 				`join sys.types as type on col.user_type_id = type.user_type_id ` +
@@ -110,6 +135,7 @@ func (s *GrammarSuite) TestCompileColumns() {
 				`left join sys.default_constraints def on col.default_object_id = def.object_id and col.object_id = def.parent_object_id ` +
 				`left join sys.extended_properties as prop on obj.object_id = prop.major_id and col.column_id = prop.minor_id and prop.name = 'MS_Description' ` +
 				`left join sys.computed_columns as com on col.column_id = com.column_id and col.object_id = com.object_id ` +
+				`left join sys.identity_columns as idc on col.column_id = idc.column_id and col.object_id = idc.object_id ` +
 				`where obj.type in ('U', 'V') and obj.name = 'goravel_users' and scm.name = schema_name() ` +
 				`order by col.column_id`,
 			expectedError: nil,
@@ -137,6 +163,87 @@ func (s *GrammarSuite) TestCompileColumns() {
 	}
 }
 
+func (s *GrammarSuite) TestCompileComment() {
+	var (
+		mockBlueprint *mocksdriver.Blueprint
+		mockColumn    *mocksdriver.ColumnDefinition
+	)
+
+	tests := []struct {
+		name      string
+		grammar   *Grammar
+		setup     func()
+		expectSql string
+	}{
+		{
+			name:    "add or update the comment",
+			grammar: s.grammar,
+			setup: func() {
+				mockBlueprint.EXPECT().GetTableName().Return("users").Once()
+				mockColumn.EXPECT().GetName().Return("name").Once()
+				mockColumn.EXPECT().IsSetComment().Return(true).Once()
+				mockColumn.EXPECT().GetComment().Return("It's the name").Once()
+			},
+			expectSql: "declare @schema sysname = schema_name(); " +
+				"if exists (select * from sys.fn_listextendedproperty(N'MS_Description', N'SCHEMA', @schema, N'TABLE', N'goravel_users', N'COLUMN', N'name')) " +
+				"exec sp_updateextendedproperty N'MS_Description', N'It''s the name', N'SCHEMA', @schema, N'TABLE', N'goravel_users', N'COLUMN', N'name' " +
+				"else exec sp_addextendedproperty N'MS_Description', N'It''s the name', N'SCHEMA', @schema, N'TABLE', N'goravel_users', N'COLUMN', N'name'",
+		},
+		{
+			name:    "with schema",
+			grammar: NewGrammarWithConfig(contracts.FullConfig{Prefix: "goravel_", Schema: "app"}),
+			setup: func() {
+				mockBlueprint.EXPECT().GetTableName().Return("hr.users").Once()
+				mockColumn.EXPECT().GetName().Return("name").Once()
+				mockColumn.EXPECT().IsSetComment().Return(true).Once()
+				mockColumn.EXPECT().GetComment().Return("comment").Once()
+			},
+			expectSql: "declare @schema sysname = 'hr'; " +
+				"if exists (select * from sys.fn_listextendedproperty(N'MS_Description', N'SCHEMA', @schema, N'TABLE', N'goravel_users', N'COLUMN', N'name')) " +
+				"exec sp_updateextendedproperty N'MS_Description', N'comment', N'SCHEMA', @schema, N'TABLE', N'goravel_users', N'COLUMN', N'name' " +
+				"else exec sp_addextendedproperty N'MS_Description', N'comment', N'SCHEMA', @schema, N'TABLE', N'goravel_users', N'COLUMN', N'name'",
+		},
+		{
+			name:    "drop the comment of a changed column",
+			grammar: s.grammar,
+			setup: func() {
+				mockBlueprint.EXPECT().GetTableName().Return("users").Once()
+				mockColumn.EXPECT().GetName().Return("name").Once()
+				mockColumn.EXPECT().IsSetComment().Return(false).Once()
+			},
+			expectSql: "declare @schema sysname = schema_name(); " +
+				"if exists (select * from sys.fn_listextendedproperty(N'MS_Description', N'SCHEMA', @schema, N'TABLE', N'goravel_users', N'COLUMN', N'name')) " +
+				"exec sp_dropextendedproperty N'MS_Description', N'SCHEMA', @schema, N'TABLE', N'goravel_users', N'COLUMN', N'name'",
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			mockBlueprint = mocksdriver.NewBlueprint(s.T())
+			mockColumn = mocksdriver.NewColumnDefinition(s.T())
+
+			test.setup()
+
+			s.Equal(test.expectSql, test.grammar.CompileComment(mockBlueprint, &driver.Command{Column: mockColumn}))
+		})
+	}
+}
+
+func (s *GrammarSuite) TestCompileTableComment() {
+	mockBlueprint := mocksdriver.NewBlueprint(s.T())
+	mockBlueprint.EXPECT().GetTableName().Return("users").Twice()
+
+	s.Equal("declare @schema sysname = schema_name(); "+
+		"if exists (select * from sys.fn_listextendedproperty(N'MS_Description', N'SCHEMA', @schema, N'TABLE', N'goravel_users', null, null)) "+
+		"exec sp_updateextendedproperty N'MS_Description', N'The users', N'SCHEMA', @schema, N'TABLE', N'goravel_users' "+
+		"else exec sp_addextendedproperty N'MS_Description', N'The users', N'SCHEMA', @schema, N'TABLE', N'goravel_users'",
+		s.grammar.CompileTableComment(mockBlueprint, &driver.Command{Value: "The users"}))
+	s.Equal("declare @schema sysname = schema_name(); "+
+		"if exists (select * from sys.fn_listextendedproperty(N'MS_Description', N'SCHEMA', @schema, N'TABLE', N'goravel_users', null, null)) "+
+		"exec sp_dropextendedproperty N'MS_Description', N'SCHEMA', @schema, N'TABLE', N'goravel_users'",
+		s.grammar.CompileTableComment(mockBlueprint, &driver.Command{Value: ""}))
+}
+
 func (s *GrammarSuite) TestCompileCreate() {
 	mockColumn1 := mocksdriver.NewColumnDefinition(s.T())
 	mockColumn2 := mocksdriver.NewColumnDefinition(s.T())
@@ -181,6 +288,26 @@ func (s *GrammarSuite) TestCompileCreate() {
 		s.grammar.CompileCreate(mockBlueprint))
 }
 
+func (s *GrammarSuite) TestCompileCreateType() {
+	s.Equal(`create type "Email" from nvarchar(320) not null`, s.grammar.CompileCreateType("Email", "nvarchar(320) not null"))
+	s.Equal(`create type "app"."Email" from nvarchar(320)`, NewGrammarWithConfig(contracts.FullConfig{Schema: "app"}).CompileCreateType("Email", "nvarchar(320)"))
+	s.Equal(`create type "hr"."Email" from nvarchar(320)`, NewGrammarWithConfig(contracts.FullConfig{Schema: "app"}).CompileCreateType("hr.Email", "nvarchar(320)"))
+}
+
+func (s *GrammarSuite) TestCompileCreateTableType() {
+	blueprint := schema.NewBlueprint(nil, "", "OrderLines")
+	blueprint.Integer("order_id")
+	blueprint.Integer("line")
+	blueprint.String("sku", 50).Nullable()
+	blueprint.Primary("order_id", "line")
+	blueprint.Unique("sku")
+	blueprint.Index("line")
+
+	s.Equal(`create type "OrderLines" as table ("order_id" int not null, "line" int not null, "sku" nvarchar(50) null, `+
+		`primary key ("order_id", "line"), unique ("sku"), index "orderlines_line_index" ("line"))`,
+		s.grammar.CompileCreateTableType(blueprint))
+}
+
 func (s *GrammarSuite) TestCompileDefault() {
 	mockBlueprint := mocksdriver.NewBlueprint(s.T())
 	mockColumnDefinition := mocksdriver.NewColumnDefinition(s.T())
@@ -210,7 +337,197 @@ func (s *GrammarSuite) TestCompileDropIfExists() {
 	mockBlueprint := mocksdriver.NewBlueprint(s.T())
 	mockBlueprint.EXPECT().GetTableName().Return("users").Once()
 
-	s.Equal(`if object_id('"goravel_users"', 'U') is not null drop table "goravel_users"`, s.grammar.CompileDropIfExists(mockBlueprint))
+	s.Equal(`if object_id('"goravel_users"', 'U') is not null begin `+
+		`declare @history nvarchar(max) = (select quotename(schema_name(h.schema_id)) + N'.' + quotename(h.name) `+
+		`from sys.tables as t join sys.tables as h on t.history_table_id = h.object_id where t.object_id = object_id(N'"goravel_users"') and t.temporal_type = 2); `+
+		`if @history is not null alter table "goravel_users" set (system_versioning = off); `+
+		`drop table "goravel_users"; `+
+		`if @history is not null exec (N'drop table ' + @history) end`, s.grammar.CompileDropIfExists(mockBlueprint))
+}
+
+func (s *GrammarSuite) TestCompileDrop() {
+	mockBlueprint := mocksdriver.NewBlueprint(s.T())
+	mockBlueprint.EXPECT().GetTableName().Return("users").Once()
+
+	sql := s.grammar.CompileDrop(mockBlueprint)
+	s.True(strings.HasPrefix(sql, "declare @history nvarchar(max)"))
+	s.Contains(sql, `if @history is not null alter table "goravel_users" set (system_versioning = off); drop table "goravel_users"; `)
+}
+
+func (s *GrammarSuite) TestSystemVersioning() {
+	blueprint := schema.NewBlueprint(nil, "", "users")
+	blueprint.Create()
+	blueprint.Increments("id")
+	SystemVersioned(blueprint, SystemVersioning{RetentionPeriod: "6 months", Hidden: true})
+
+	s.Equal(`create table "goravel_users" ("id" int identity primary key not null, `+
+		`"valid_from" datetime2 generated always as row start hidden not null default sysutcdatetime(), `+
+		`"valid_to" datetime2 generated always as row end hidden not null default convert(datetime2, '9999-12-31 23:59:59.9999999'), `+
+		`period for system_time ("valid_from", "valid_to")) `+
+		`with (system_versioning = on (history_table = "dbo"."goravel_users_history", history_retention_period = 6 MONTHS))`,
+		s.grammar.CompileCreate(blueprint))
+
+	grammar := NewGrammarWithConfig(contracts.FullConfig{Prefix: "goravel_", Schema: "app"})
+	s.Equal([]string{
+		`if col_length(N'"app"."goravel_users"', N'starts_at') is null alter table "app"."goravel_users" add ` +
+			`"starts_at" datetime2 generated always as row start not null default sysutcdatetime(), ` +
+			`"ends_at" datetime2 generated always as row end not null default convert(datetime2, '9999-12-31 23:59:59.9999999'), ` +
+			`period for system_time ("starts_at", "ends_at")`,
+		`alter table "app"."goravel_users" set (system_versioning = on (history_table = "history"."goravel_users"))`,
+	}, grammar.CompileEnableSystemVersioning("users", SystemVersioning{HistoryTable: "history.users", ValidFrom: "starts_at", ValidTo: "ends_at"}))
+	s.Equal(`alter table "app"."goravel_users" set (system_versioning = off)`, grammar.CompileDisableSystemVersioning("users"))
+}
+
+func (s *GrammarSuite) TestCompileFullText() {
+	// The full-text index can't be created in the transaction that the blueprint is built in.
+	s.Empty(s.grammar.CompileFullText(mocksdriver.NewBlueprint(s.T()), &driver.Command{Columns: []string{"title"}}))
+}
+
+func (s *GrammarSuite) TestCompileCreateFullTextIndex() {
+	tests := []struct {
+		name      string
+		grammar   *Grammar
+		columns   []string
+		options   FullTextIndex
+		expectSql string
+	}{
+		{
+			name:    "default catalog and key index",
+			grammar: NewGrammarWithConfig(contracts.FullConfig{Connection: "sqlserver", Prefix: "goravel_"}),
+			columns: []string{"title", "body"},
+			expectSql: `if not exists (select * from sys.fulltext_catalogs where name = N'sqlserver_fulltext') create fulltext catalog "sqlserver_fulltext"; ` +
+				`declare @key sysname = (select top 1 name from sys.indexes where object_id = object_id(N'"goravel_posts"') and (is_primary_key = 1 or is_unique = 1) order by is_primary_key desc, index_id); ` +
+				`if @key is null throw 50000, N'The full-text index of "goravel_posts" needs a primary key or a unique index.', 1; ` +
+				`declare @sql nvarchar(max) = N'create fulltext index on "goravel_posts" ("title", "body") key index ' + quotename(@key) + N' on "sqlserver_fulltext"'; ` +
+				`exec sp_executesql @sql`,
+		},
+		{
+			name:    "with options",
+			grammar: NewGrammarWithConfig(contracts.FullConfig{Connection: "sqlserver", FullTextCatalog: "goravel", Prefix: "goravel_", Schema: "app"}),
+			columns: []string{"title", "body"},
+			options: FullTextIndex{
+				Catalog:        "posts",
+				ChangeTracking: "off, no population",
+				KeyIndex:       "goravel_posts_slug_unique",
+				Language:       "English",
+				Languages:      map[string]string{"title": "1041"},
+			},
+			expectSql: `if not exists (select * from sys.fulltext_catalogs where name = N'posts') create fulltext catalog "posts"; ` +
+				`declare @key sysname = N'goravel_posts_slug_unique'; ` +
+				`if @key is null throw 50000, N'The full-text index of "app"."goravel_posts" needs a primary key or a unique index.', 1; ` +
+				`declare @sql nvarchar(max) = N'create fulltext index on "app"."goravel_posts" ("title" language 1041, "body" language N''English'') key index ' + quotename(@key) + N' on "posts" with change_tracking = OFF, NO POPULATION'; ` +
+				`exec sp_executesql @sql`,
+		},
+		{
+			name:    "catalog of the connection",
+			grammar: NewGrammarWithConfig(contracts.FullConfig{Connection: "sqlserver", FullTextCatalog: "goravel", Prefix: "goravel_"}),
+			columns: []string{"body"},
+			options: FullTextIndex{ChangeTracking: "manual", Language: "Brazilian'"},
+			expectSql: `if not exists (select * from sys.fulltext_catalogs where name = N'goravel') create fulltext catalog "goravel"; ` +
+				`declare @key sysname = (select top 1 name from sys.indexes where object_id = object_id(N'"goravel_posts"') and (is_primary_key = 1 or is_unique = 1) order by is_primary_key desc, index_id); ` +
+				`if @key is null throw 50000, N'The full-text index of "goravel_posts" needs a primary key or a unique index.', 1; ` +
+				`declare @sql nvarchar(max) = N'create fulltext index on "goravel_posts" ("body" language N''Brazilian'''''') key index ' + quotename(@key) + N' on "goravel" with change_tracking = MANUAL'; ` +
+				`exec sp_executesql @sql`,
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.Equal(test.expectSql, test.grammar.CompileCreateFullTextIndex("posts", test.columns, test.options))
+		})
+	}
+}
+
+func (s *GrammarSuite) TestCompileFullTextQuery() {
+	sql, args := s.grammar.CompileFullTextContains([]string{"title"}, FullTextPrefix("data"), false)
+	s.Equal(`contains("title", ?)`, sql)
+	s.Equal([]any{`"data*"`}, args)
+
+	sql, args = s.grammar.CompileFullTextContains([]string{"title", "body"}, FullTextNear(5, "sql", "server"), true)
+	s.Equal(`not contains(("title", "body"), ?)`, sql)
+	s.Equal([]any{`NEAR(("sql", "server"), 5)`}, args)
+
+	sql, args = s.grammar.CompileFullTextFreeText([]string{"*"}, "fast database", false)
+	s.Equal(`freetext(*, ?)`, sql)
+	s.Equal([]any{"fast database"}, args)
+
+	sql, args = s.grammar.CompileFullTextRank("posts", "id", []string{"title", "body"}, "database", false)
+	s.Equal(`containstable("goravel_posts", ("title", "body"), ?) as "ft" on "goravel_posts"."id" = "ft"."KEY"`, sql)
+	s.Equal([]any{"database"}, args)
+
+	sql, args = NewGrammarWithConfig(contracts.FullConfig{Prefix: "goravel_", Schema: "app"}).CompileFullTextRank("posts", "id", []string{"body"}, "fast database", true)
+	s.Equal(`freetexttable("app"."goravel_posts", "body", ?) as "ft" on "app"."goravel_posts"."id" = "ft"."KEY"`, sql)
+	s.Equal([]any{"fast database"}, args)
+}
+
+func (s *GrammarSuite) TestCompileDropAllTables() {
+	drop := func(objects string) string {
+		return `IF OBJECT_ID('tempdb..#drop_tables') IS NOT NULL DROP TABLE #drop_tables;
+CREATE TABLE #drop_tables (object_id INT PRIMARY KEY, history_table_id INT NULL);
+INSERT INTO #drop_tables SELECT object_id, history_table_id FROM sys.tables WHERE is_memory_optimized = 0 AND object_id IN (` + objects + `;
+IF COL_LENGTH('sys.tables', 'ledger_type') IS NOT NULL
+    EXEC(N'DELETE FROM #drop_tables WHERE object_id IN (SELECT object_id FROM sys.tables WHERE ledger_type <> 0)');
+DELETE FROM #drop_tables WHERE object_id IN (SELECT history_table_id FROM sys.tables WHERE object_id NOT IN (SELECT object_id FROM #drop_tables));
+INSERT INTO #drop_tables SELECT history_table_id, NULL FROM #drop_tables WHERE history_table_id IS NOT NULL AND history_table_id NOT IN (SELECT object_id FROM #drop_tables);
+
+DECLARE @sql NVARCHAR(MAX) = N'';
+SELECT @sql += N'ALTER TABLE ' + QUOTENAME(OBJECT_SCHEMA_NAME(object_id)) + N'.' + QUOTENAME(OBJECT_NAME(object_id)) + N' SET (SYSTEM_VERSIONING = OFF);'
+FROM #drop_tables WHERE history_table_id IS NOT NULL;
+SELECT @sql += N'ALTER TABLE ' + QUOTENAME(OBJECT_SCHEMA_NAME(parent_object_id)) + N'.' + QUOTENAME(OBJECT_NAME(parent_object_id)) + N' DROP CONSTRAINT ' + QUOTENAME(name) + N';'
+FROM sys.foreign_keys WHERE parent_object_id IN (SELECT object_id FROM #drop_tables) OR referenced_object_id IN (SELECT object_id FROM #drop_tables);
+SELECT @sql += N'DROP TABLE ' + QUOTENAME(OBJECT_SCHEMA_NAME(object_id)) + N'.' + QUOTENAME(OBJECT_NAME(object_id)) + N';'
+FROM #drop_tables;
+
+DROP TABLE #drop_tables;
+EXEC sp_executesql @sql;`
+	}
+
+	sqls := s.grammar.CompileDropAllTables("", []driver.Table{
+		{Name: "goravel_users", Schema: "dbo"},
+		{Name: "goravel_users_history"},
+	})
+	s.Equal(drop(`OBJECT_ID(N'"dbo"."goravel_users"'), OBJECT_ID(N'"goravel_users_history"'))`), sqls[0])
+
+	sqls = NewGrammarWithConfig(contracts.FullConfig{Schema: "app"}).CompileDropAllTables("app", []driver.Table{{Name: "o'users", Schema: "app"}})
+	s.Equal(drop(`OBJECT_ID(N'"app"."o''users"')) AND schema_id = SCHEMA_ID(N'app')`), sqls[0])
+}
+
+func (s *GrammarSuite) TestCompileDropAllTablesSequences() {
+	drop := func(filter string) string {
+		return `
+DECLARE @sql NVARCHAR(MAX) = N'';
+SELECT @sql += N'DROP SEQUENCE ' + QUOTENAME(OBJECT_SCHEMA_NAME(object_id)) + N'.' + QUOTENAME(name) + N';'
+FROM sys.sequences WHERE object_id NOT IN (SELECT referenced_id FROM sys.sql_expression_dependencies WHERE referenced_id IS NOT NULL)` + filter + `;
+EXEC sp_executesql @sql;`
+	}
+
+	s.Equal([]string{drop("")}, s.grammar.CompileDropAllTables("", nil))
+
+	sqls := s.grammar.CompileDropAllTables("", []driver.Table{{Name: "goravel_users"}})
+	s.Len(sqls, 2)
+	s.Equal(drop(""), sqls[1])
+
+	sqls = NewGrammarWithConfig(contracts.FullConfig{Schema: "app"}).CompileDropAllTables("app", []driver.Table{{Name: "users", Schema: "app"}})
+	s.Len(sqls, 2)
+	s.Equal(drop(" AND schema_id = SCHEMA_ID(N'app')"), sqls[1])
+}
+
+func (s *GrammarSuite) TestCompileDropAllTypes() {
+	s.Equal([]string{
+		`drop type if exists "dbo"."OrderLines"`,
+		`drop type if exists "dbo"."Email"`,
+		`drop type if exists "hr"."Phone"`,
+	}, s.grammar.CompileDropAllTypes("", []driver.Type{
+		{Name: "Email", Schema: "dbo", Type: "alias", Category: "string"},
+		{Name: "OrderLines", Schema: "dbo", Type: "table", Category: "composite"},
+		{Name: "Phone", Schema: "hr", Type: "alias", Category: "string"},
+	}))
+	s.Equal(`drop type if exists "Email"`, s.grammar.CompileDropType("Email"))
+}
+
+func (s *GrammarSuite) TestCompileDropFullText() {
+	s.Empty(s.grammar.CompileDropFullText(mocksdriver.NewBlueprint(s.T()), &driver.Command{}))
+	s.Equal(`drop fulltext index on "goravel_posts"`, s.grammar.CompileDropFullTextIndex("posts"))
 }
 
 func (s *GrammarSuite) TestCompileForeign() {
@@ -542,6 +859,218 @@ func (s *GrammarSuite) TestCompilePrimary() {
 	}))
 }
 
+func (s *GrammarSuite) TestTableIndex() {
+	blueprint := schema.NewBlueprint(nil, "", "orders")
+	blueprint.Primary("id")
+	TableIndex(blueprint, Index{NonClustered: true, Online: true})
+	blueprint.Index("customer_id", "created_at")
+	TableIndex(blueprint, Index{
+		Descending:      []string{"created_at"},
+		Include:         []string{"total", "status"},
+		Where:           "[deleted_at] is null",
+		FillFactor:      80,
+		PadIndex:        true,
+		DataCompression: "PAGE",
+		FileGroup:       "indexes",
+	})
+	blueprint.Unique("number")
+	TableIndex(blueprint, Index{Clustered: true})
+	blueprint.Index("status")
+
+	commands := blueprint.GetCommands()
+	s.Equal(`alter table "goravel_orders" add constraint "orders_id_primary" primary key nonclustered ("id") with (online = on)`,
+		s.grammar.CompilePrimary(blueprint, commands[0]))
+	s.Equal(`create index "orders_customer_id_created_at_index" on "goravel_orders" ("customer_id", "created_at" desc) include ("total", "status") where [deleted_at] is null with (pad_index = on, fillfactor = 80, data_compression = page) on "indexes"`,
+		s.grammar.CompileIndex(blueprint, commands[1]))
+	s.Equal(`create unique clustered index "orders_number_unique" on "goravel_orders" ("number")`,
+		s.grammar.CompileUnique(blueprint, commands[2]))
+	s.Equal(`create index "orders_status_index" on "goravel_orders" ("status")`,
+		s.grammar.CompileIndex(blueprint, commands[3]))
+
+	sql, err := s.grammar.CompileIndexDetails("", "orders")
+	s.NoError(err)
+	s.Equal("select idx.name as name, "+
+		"coalesce(string_agg(case when idxcol.is_included_column = 0 and idxcol.is_descending_key = 1 then col.name end, ',') "+
+		"within group (order by idxcol.key_ordinal, idxcol.index_column_id), '') as descending, "+
+		"coalesce(string_agg(case when idxcol.is_included_column = 1 and idx.type not in (5, 6) then col.name end, ',') "+
+		"within group (order by idxcol.key_ordinal, idxcol.index_column_id), '') as include, "+
+		"coalesce(idx.filter_definition, '') as [where] "+
+		"from sys.indexes as idx "+
+		"join sys.tables as tbl on idx.object_id = tbl.object_id "+
+		"join sys.schemas as scm on tbl.schema_id = scm.schema_id "+
+		"join sys.index_columns as idxcol on idx.object_id = idxcol.object_id and idx.index_id = idxcol.index_id "+
+		"join sys.columns as col on idxcol.object_id = col.object_id and idxcol.column_id = col.column_id "+
+		"where tbl.name = 'goravel_orders' and scm.name = schema_name() "+
+		"group by idx.name, idx.filter_definition", sql)
+
+	// The kind of the index is the type, the details are reported by CompileIndexDetails.
+	sql, err = s.grammar.CompileIndexes("", "orders")
+	s.NoError(err)
+	s.Contains(sql, "idx.type_desc as [type]")
+}
+
+func (s *GrammarSuite) TestColumnstoreIndex() {
+	blueprint := schema.NewBlueprint(nil, "", "sales")
+	TableColumnstoreIndex(blueprint, ColumnstoreIndex{Clustered: true, Order: []string{"sold_at"}, Archive: true})
+	TableColumnstoreIndex(blueprint, ColumnstoreIndex{Where: "[sold_at] >= '2024-01-01'"}, "product_id", "total")
+	DropColumnstoreIndex(blueprint)
+	blueprint.DropIndex("product_id")
+
+	commands := blueprint.GetCommands()
+	s.Equal(`create clustered columnstore index "sales_columnstore" on "goravel_sales" order ("sold_at") with (data_compression = columnstore_archive)`,
+		s.grammar.CompileIndex(blueprint, commands[0]))
+	s.Equal(`create nonclustered columnstore index "sales_columnstore" on "goravel_sales" ("product_id", "total") where [sold_at] >= '2024-01-01'`,
+		s.grammar.CompileIndex(blueprint, commands[1]))
+	s.Equal(`declare @index sysname = (select name from sys.indexes where object_id = object_id(N'"goravel_sales"') and type in (5, 6)); if @index is not null exec(N'drop index ' + quotename(@index) + N' on "goravel_sales"')`,
+		s.grammar.CompileDropIndex(blueprint, commands[2]))
+	s.Equal(`drop index "sales_product_id_index" on "goravel_sales"`, s.grammar.CompileDropIndex(blueprint, commands[3]))
+
+	sql, err := s.grammar.CompileIndexes("", "sales")
+	s.NoError(err)
+	s.Contains(sql, "case when idxcol.is_included_column = 0 or idx.type in (5, 6) then col.name end")
+	s.Contains(sql, "idx.type_desc as [type]")
+}
+
+func (s *GrammarSuite) TestUniqueNullsDistinct() {
+	blueprint := schema.NewBlueprint(nil, "", "users")
+	blueprint.String("email")
+	blueprint.String("external_id").Nullable()
+	blueprint.Unique("external_id")
+	blueprint.Unique("email")
+	blueprint.Unique("email", "external_id", "tenant_id")
+	blueprint.Unique("external_id")
+	TableIndex(blueprint, Index{NullsDistinct: convert.Pointer(false)})
+
+	commands := blueprint.GetCommands()[2:]
+	grammar := NewGrammarWithConfig(contracts.FullConfig{Prefix: "goravel_", UniqueNullsDistinct: true})
+	s.Equal(`create unique index "users_external_id_unique" on "goravel_users" ("external_id") where "external_id" is not null`,
+		grammar.CompileUnique(blueprint, commands[0]))
+	s.Equal(`create unique index "users_email_unique" on "goravel_users" ("email")`,
+		grammar.CompileUnique(blueprint, commands[1]))
+	s.Equal(`create unique index "users_email_external_id_tenant_id_unique" on "goravel_users" ("email", "external_id", "tenant_id") where "external_id" is not null and "tenant_id" is not null`,
+		grammar.CompileUnique(blueprint, commands[2]))
+	s.Equal(`create unique index "users_external_id_unique" on "goravel_users" ("external_id")`,
+		grammar.CompileUnique(blueprint, commands[3]))
+
+	blueprint = schema.NewBlueprint(nil, "", "users")
+	blueprint.Unique("external_id")
+	TableIndex(blueprint, Index{NullsDistinct: convert.Pointer(true), Where: "[deleted_at] is null"})
+	blueprint.Index("external_id")
+	TableIndex(blueprint, Index{NullsDistinct: convert.Pointer(true)})
+
+	commands = blueprint.GetCommands()
+	s.Equal(`create unique index "users_external_id_unique" on "goravel_users" ("external_id") where [deleted_at] is null and "external_id" is not null`,
+		s.grammar.CompileUnique(blueprint, commands[0]))
+	s.Equal(`create index "users_external_id_index" on "goravel_users" ("external_id")`,
+		s.grammar.CompileIndex(blueprint, commands[1]))
+}
+
+func (s *GrammarSuite) TestDefaultSchema() {
+	grammar := NewGrammarWithConfig(contracts.FullConfig{Prefix: "goravel_", Schema: "app"})
+
+	s.Run("introspection", func() {
+		sql, err := grammar.CompileColumns("", "users")
+		s.NoError(err)
+		s.Contains(sql, "obj.name = 'goravel_users' and scm.name = 'app'")
+
+		sql, err = grammar.CompileColumns("", "dbo.users")
+		s.NoError(err)
+		s.Contains(sql, "obj.name = 'goravel_users' and scm.name = 'dbo'")
+
+		sql, err = grammar.CompileIndexes("", "users")
+		s.NoError(err)
+		s.Contains(sql, "tbl.name = 'goravel_users' and scm.name = 'app'")
+
+		sql, err = grammar.CompileIndexes("app", "dbo.users")
+		s.NoError(err)
+		s.Contains(sql, "tbl.name = 'goravel_users' and scm.name = 'dbo'")
+		s.Contains(sql, "from sys.fulltext_indexes as idx ")
+		s.Equal(2, strings.Count(sql, "scm.name = 'dbo'"))
+
+		s.Contains(grammar.CompileForeignKeys("", "goravel_users"), "WHERE lt.name = 'goravel_users' AND ls.name = 'app'")
+		s.Contains(grammar.CompileForeignKeys("dbo", "goravel_users"), "WHERE lt.name = 'goravel_users' AND ls.name = 'dbo'")
+		s.Contains(s.grammar.CompileForeignKeys("", "goravel_users"), "WHERE lt.name = 'goravel_users' AND ls.name = schema_name()")
+	})
+
+	s.Run("tables and views", func() {
+		s.Equal("select t.name as name, schema_name(t.schema_id) as [schema], sum(u.total_pages) * 8 * 1024 as size, "+
+			"max(cast(prop.value as nvarchar(4000))) as comment "+
+			"from sys.tables as t "+
+			"join sys.partitions as p on p.object_id = t.object_id "+
+			"join sys.allocation_units as u on u.container_id = p.hobt_id "+
+			"left join sys.extended_properties as prop on prop.class = 1 and t.object_id = prop.major_id and prop.minor_id = 0 and prop.name = 'MS_Description' "+
+			"where t.schema_id = schema_id('app') "+
+			"group by t.name, t.schema_id "+
+			"order by t.name", grammar.CompileTables("goravel"))
+		s.Equal("select name, schema_name(v.schema_id) as [schema], definition from sys.views as v "+
+			"inner join sys.sql_modules as m on v.object_id = m.object_id "+
+			"where v.schema_id = schema_id('app') "+
+			"order by name", grammar.CompileViews("goravel"))
+		s.Equal("select t.name as name, schema_name(t.schema_id) as [schema], "+
+			"case when t.is_table_type = 1 then 'table' else 'alias' end as [type], "+
+			"case when t.is_table_type = 1 then 'table' else type_name(t.system_type_id) end as category, "+
+			"cast(0 as bit) as implicit "+
+			"from (select * from sys.types where is_user_defined = 1) as t "+
+			"where t.schema_id = schema_id('app') "+
+			"order by t.name", grammar.CompileTypes())
+		s.NotContains(s.grammar.CompileTypes(), "schema_id('")
+		s.NotContains(s.grammar.CompileTables("goravel"), "where")
+		s.NotContains(s.grammar.CompileViews("goravel"), "where")
+	})
+
+	s.Run("ddl", func() {
+		mockBlueprint := mocksdriver.NewBlueprint(s.T())
+		mockBlueprint.EXPECT().GetTableName().Return("users").Times(3)
+
+		s.Contains(grammar.CompileDropIfExists(mockBlueprint), `if object_id('"app"."goravel_users"', 'U') is not null begin `)
+		s.Equal(`sp_rename '"app"."goravel_users"', "goravel_people"`, grammar.CompileRename(mockBlueprint, &driver.Command{To: "people"}))
+		s.Equal(`alter table "app"."goravel_users" add constraint "fk_users_role_id" foreign key ("role_id") references "dbo"."goravel_roles" ("id")`,
+			grammar.CompileForeign(mockBlueprint, &driver.Command{
+				Index:      "fk_users_role_id",
+				Columns:    []string{"role_id"},
+				On:         "dbo.roles",
+				References: []string{"id"},
+			}))
+	})
+}
+
+func (s *GrammarSuite) TestCompileReseed() {
+	value := int64(999)
+
+	s.Equal(`dbcc checkident (N'"goravel_users"', reseed)`, s.grammar.CompileReseed("users", nil))
+	s.Equal(`dbcc checkident (N'"app"."goravel_users"', reseed, 999)`,
+		NewGrammarWithConfig(contracts.FullConfig{Prefix: "goravel_", Schema: "app"}).CompileReseed("users", &value))
+}
+
+func (s *GrammarSuite) TestCompileSequence() {
+	start, minValue, maxValue := int64(1000), int64(1000), int64(9999)
+
+	s.Equal(`create sequence "order_numbers" as bigint`, s.grammar.CompileCreateSequence(Sequence{Name: "order_numbers"}))
+	s.Equal(`create sequence "sales"."order_numbers" as int start with 1000 increment by 1 minvalue 1000 maxvalue 9999 cycle cache 50`,
+		s.grammar.CompileCreateSequence(Sequence{
+			Name:      "order_numbers",
+			Schema:    "sales",
+			Type:      "int",
+			Start:     &start,
+			Increment: 1,
+			MinValue:  &minValue,
+			MaxValue:  &maxValue,
+			Cycle:     true,
+			Cache:     50,
+		}))
+	s.Equal(`alter sequence "sales"."order_numbers" restart with 1000 increment by -1 no cycle no cache`,
+		s.grammar.CompileAlterSequence(Sequence{Name: "sales.order_numbers", Start: &start, Increment: -1, Cache: -1}))
+	s.Equal(`drop sequence if exists "order_numbers"`, s.grammar.CompileDropSequence("order_numbers"))
+
+	grammar := NewGrammarWithConfig(contracts.FullConfig{Prefix: "goravel_", Schema: "app"})
+	s.Equal(`create sequence "app"."order_numbers" as bigint`, grammar.CompileCreateSequence(Sequence{Name: "order_numbers"}))
+	s.Equal(`select next value for "app"."order_numbers" as value`, grammar.CompileNextValue("order_numbers"))
+	s.Equal(`declare @first sql_variant; exec sp_sequence_get_range @sequence_name = N'"app"."order_numbers"', @range_size = 10, @range_first_value = @first output; select cast(@first as bigint) as value`,
+		grammar.CompileSequenceRange("order_numbers", 10))
+	s.Contains(grammar.CompileSequences(), "from sys.sequences as s where s.schema_id = schema_id('app') order by s.name")
+	s.NotContains(s.grammar.CompileSequences(), "where")
+}
+
 func (s *GrammarSuite) TestCompileRenameColumn() {
 	mockBlueprint := mocksdriver.NewBlueprint(s.T())
 	mockColumn := mocksdriver.NewColumnDefinition(s.T())
@@ -585,6 +1114,97 @@ func (s *GrammarSuite) TestGetColumns() {
 	s.Equal([]string{`"id" int identity primary key not null`, `"name" nvarchar(10) default 'goravel' null`}, s.grammar.getColumns(mockBlueprint))
 }
 
+func (s *GrammarSuite) TestModifyCollate() {
+	var (
+		grammar    *Grammar
+		blueprint  driver.Blueprint
+		column     driver.ColumnDefinition
+		mockColumn *mocksdriver.ColumnDefinition
+	)
+
+	tests := []struct {
+		name      string
+		setup     func()
+		expectSql string
+	}{
+		{
+			name: "without collation",
+			setup: func() {
+				grammar = s.grammar
+			},
+		},
+		{
+			name: "with connection collation",
+			setup: func() {
+				grammar = NewGrammarWithConfig(contracts.FullConfig{Collation: "Latin1_General_CI_AS"})
+				mockColumn.EXPECT().GetType().Return("string").Once()
+			},
+			expectSql: " collate Latin1_General_CI_AS",
+		},
+		{
+			name: "with connection collation but not a string column",
+			setup: func() {
+				grammar = NewGrammarWithConfig(contracts.FullConfig{Collation: "Latin1_General_CI_AS"})
+				mockColumn.EXPECT().GetType().Return("integer").Once()
+			},
+		},
+		{
+			name: "with table collation",
+			setup: func() {
+				grammar = NewGrammarWithConfig(contracts.FullConfig{Collation: "Latin1_General_CI_AS"})
+				tableBlueprint := schema.NewBlueprint(nil, "", "users")
+				TableCollation(tableBlueprint, "Japanese_CI_AS")
+				blueprint = tableBlueprint
+				mockColumn.EXPECT().GetType().Return("text").Once()
+			},
+			expectSql: " collate Japanese_CI_AS",
+		},
+		{
+			name: "with column collation",
+			setup: func() {
+				grammar = NewGrammarWithConfig(contracts.FullConfig{Collation: "Latin1_General_CI_AS"})
+				tableBlueprint := schema.NewBlueprint(nil, "", "users")
+				TableCollation(tableBlueprint, "Japanese_CI_AS")
+				blueprint = tableBlueprint
+				column = Collation(tableBlueprint.String("name"), "Latin1_General_100_CI_AS_SC_UTF8")
+			},
+			expectSql: " collate Latin1_General_100_CI_AS_SC_UTF8",
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			blueprint = mocksdriver.NewBlueprint(s.T())
+			mockColumn = mocksdriver.NewColumnDefinition(s.T())
+			column = mockColumn
+
+			test.setup()
+
+			sql := grammar.ModifyCollate(blueprint, column)
+
+			s.Equal(test.expectSql, sql)
+		})
+	}
+}
+
+func (s *GrammarSuite) TestUTF8Collation() {
+	mockBlueprint := mocksdriver.NewBlueprint(s.T())
+	mockColumn := mocksdriver.NewColumnDefinition(s.T())
+	grammar := NewGrammarWithConfig(contracts.FullConfig{Prefix: "goravel_", Collation: "Latin1_General_100_CI_AS_SC_UTF8"})
+
+	mockBlueprint.EXPECT().GetTableName().Return("users").Once()
+	mockColumn.EXPECT().GetName().Return("name").Once()
+	// getType, getCollation, ModifyCollate, ModifyIncrement
+	mockColumn.EXPECT().GetType().Return("string").Times(4)
+	mockColumn.EXPECT().GetLength().Return(100).Once()
+	mockColumn.EXPECT().GetDefault().Return(nil).Once()
+	mockColumn.EXPECT().GetNullable().Return(false).Once()
+	mockColumn.EXPECT().IsChange().Return(false).Twice()
+
+	s.Equal(`alter table "goravel_users" add "name" varchar(100) collate Latin1_General_100_CI_AS_SC_UTF8 not null`,
+		grammar.CompileAdd(mockBlueprint, &driver.Command{Column: mockColumn}))
+}
+
 func (s *GrammarSuite) TestModifyDefault() {
 	var (
 		mockBlueprint *mocksdriver.Blueprint
@@ -649,6 +1269,59 @@ func (s *GrammarSuite) TestModifyIncrement() {
 	mockColumn.EXPECT().IsChange().Return(false).Once()
 
 	s.Equal(" identity primary key", s.grammar.ModifyIncrement(mockBlueprint, mockColumn))
+
+	mockBlueprint.EXPECT().HasCommand("primary").Return(true).Once()
+	blueprint := schema.NewBlueprint(nil, "", "users")
+	s.Equal(" identity(1000, 10)", s.grammar.ModifyIncrement(mockBlueprint, Identity(blueprint.Increments("id"), 1000, 10)))
+	s.Equal(" identity(-1, -1)", s.grammar.ModifyIncrement(mockBlueprint, Identity(blueprint.Integer("number"), -1, -1)))
+
+	mockColumn = mocksdriver.NewColumnDefinition(s.T())
+	mockColumn.EXPECT().GetType().Return("integer").Once()
+	mockColumn.EXPECT().GetAutoIncrement().Return(false).Once()
+	mockColumn.EXPECT().IsChange().Return(false).Once()
+
+	s.Empty(s.grammar.ModifyIncrement(mockBlueprint, mockColumn))
+}
+
+func (s *GrammarSuite) TestSpatial() {
+	blueprint := schema.NewBlueprint(nil, "", "stores")
+	blueprint.Create()
+	blueprint.ID()
+	Geography(blueprint, "location", 4326)
+	Geometry(blueprint, "area").Nullable()
+	TableSpatialIndex(blueprint, "location", SpatialIndex{
+		Tessellation:   "GEOGRAPHY_GRID",
+		Grids:          []string{"HIGH", "", "LOW"},
+		CellsPerObject: 16,
+	})
+	TableSpatialIndex(blueprint, "area", SpatialIndex{BoundingBox: []float64{0, 0, 500.5, 200}})
+	blueprint.Index("id")
+
+	s.Equal(`create table "goravel_stores" ("id" bigint identity primary key not null, "location" geography not null check ("location".STSrid = 4326), "area" geometry null)`,
+		s.grammar.CompileCreate(blueprint))
+
+	commands := blueprint.GetCommands()
+	s.Equal(`create spatial index "stores_location_index" on "goravel_stores" ("location") using geography_grid with (grids = (level_1 = high, level_2 = medium, level_3 = low), cells_per_object = 16)`,
+		s.grammar.CompileIndex(blueprint, commands[1]))
+	s.Equal(`create spatial index "stores_area_index" on "goravel_stores" ("area") with (bounding_box = (0, 0, 500.5, 200))`,
+		s.grammar.CompileIndex(blueprint, commands[2]))
+	s.Equal(`create index "stores_id_index" on "goravel_stores" ("id")`, s.grammar.CompileIndex(blueprint, commands[3]))
+
+	sql, args := s.grammar.CompileSpatialDistanceWithin("location", GeographyPoint(47.6062, -122.3321, 4326), 5000)
+	s.Equal(`"location".STDistance(geography::Point(?, ?, ?)) <= ?`, sql)
+	s.Equal([]any{47.6062, -122.3321, 4326, float64(5000)}, args)
+
+	sql, args = s.grammar.CompileSpatialDistance("location", GeographyFromText("POINT(-122.3321 47.6062)", 4326))
+	s.Equal(`"location".STDistance(geography::STGeomFromText(?, ?))`, sql)
+	s.Equal([]any{"POINT(-122.3321 47.6062)", 4326}, args)
+
+	sql, args = s.grammar.CompileSpatialIntersects("area", GeometryFromText("POLYGON((0 0, 10 0, 10 10, 0 0))", 0), false)
+	s.Equal(`"area".STIntersects(geometry::STGeomFromText(?, ?)) = 1`, sql)
+	s.Equal([]any{"POLYGON((0 0, 10 0, 10 10, 0 0))", 0}, args)
+
+	sql, args = s.grammar.CompileSpatialContains("area", GeometryPoint(1, 2, 0), true)
+	s.Equal(`"area".STContains(geometry::Point(?, ?, ?)) = 0`, sql)
+	s.Equal([]any{float64(1), float64(2), 0}, args)
 }
 
 func (s *GrammarSuite) TestTypeBoolean() {
@@ -684,6 +1357,17 @@ func (s *GrammarSuite) TestTypeFloat() {
 	s.Equal("float(2)", s.grammar.TypeFloat(mockColumn))
 }
 
+func (s *GrammarSuite) TestRowVersion() {
+	s.Equal("rowversion", s.grammar.TypeRowVersion(mocksdriver.NewColumnDefinition(s.T())))
+
+	sql, args := s.grammar.CompileUpdateWithRowVersion("app.goravel_users", "id", "row_version", map[string]any{"name": "goravel", "age": 18})
+	s.Equal(`declare @versions table (version binary(8)); update "app"."goravel_users" set "age" = ?, "name" = ? output inserted."row_version" into @versions where "id" = ? and "row_version" = ?; select version from @versions`, sql)
+	s.Equal([]any{18, "goravel"}, args)
+
+	sql, _ = NewGrammarWithConfig(contracts.FullConfig{Prefix: "goravel_", Schema: "app"}).CompileUpdateWithRowVersion("goravel_users", "id", "row_version", map[string]any{"name": "goravel"})
+	s.Equal(`declare @versions table (version binary(8)); update "app"."goravel_users" set "name" = ? output inserted."row_version" into @versions where "id" = ? and "row_version" = ?; select version from @versions`, sql)
+}
+
 func (s *GrammarSuite) TestTypeString() {
 	mockColumn1 := mocksdriver.NewColumnDefinition(s.T())
 	mockColumn1.EXPECT().GetLength().Return(100).Once()
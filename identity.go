@@ -0,0 +1,20 @@
+package sqlserver
+
+import (
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
+)
+
+// Reseed Set the current identity value of the table, the next row gets the value plus the increment. The current
+// value is corrected to the max value in the identity column when the value is omitted.
+//
+//	driver.Reseed(facades.Orm(), "users", 999)
+func (r *Sqlserver) Reseed(orm contractsorm.Orm, table string, value ...int64) error {
+	var reseed *int64
+	if len(value) > 0 {
+		reseed = &value[0]
+	}
+
+	_, err := orm.Query().Exec(r.grammar().CompileReseed(table, reseed))
+
+	return err
+}
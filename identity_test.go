@@ -0,0 +1,13 @@
+package sqlserver
+
+import (
+	"testing"
+
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
+)
+
+func TestReseed(t *testing.T) {
+	assertDriverError(t, func(sqlserver *Sqlserver, orm contractsorm.Orm) error {
+		return sqlserver.Reseed(orm, "users", 999)
+	})
+}
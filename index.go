@@ -0,0 +1,132 @@
+package sqlserver
+
+import (
+	"strings"
+
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
+	contractsschema "github.com/goravel/framework/contracts/database/schema"
+	"github.com/goravel/framework/database/schema"
+)
+
+// ColumnstoreIndex The options of the columnstore index, a table has one columnstore index at most.
+type ColumnstoreIndex struct {
+	// Clustered Store the whole table in the columnstore, the index has no columns.
+	Clustered bool
+	// Order The columns the row groups are sorted by, it needs SQL Server 2022.
+	Order []string
+	// Where The predicate of the filtered nonclustered index.
+	Where string
+	// Archive Compress the index further with COLUMNSTORE_ARCHIVE, for the data that is rarely read.
+	Archive bool
+}
+
+// Index The SQL Server options of the index, the unique index or the primary key, the zero values keep the defaults of
+// SQL Server.
+type Index struct {
+	// Descending The key columns sorted in descending order.
+	Descending []string
+	// Include The non-key columns stored in the leaf level of the index, it can cover the queries that read them.
+	Include []string
+	// Where The predicate of the filtered index, such as [deleted_at] is null. It's ignored by the primary key.
+	Where string
+	// NullsDistinct Allow multiple nulls in the nullable columns of the unique index, it's filtered by the columns
+	// that are not null. The unique_nulls_distinct of the connection is used when nil.
+	NullsDistinct *bool
+	// Clustered Store the rows of the table in the order of the index, a table has one clustered index at most.
+	Clustered bool
+	// NonClustered Create the primary key as a nonclustered index, the primary key is clustered by default.
+	NonClustered bool
+	// FillFactor The percentage, 1 to 100, of the leaf pages filled when the index is built.
+	FillFactor int
+	// PadIndex Apply the fill factor to the intermediate pages too.
+	PadIndex bool
+	// DataCompression NONE, ROW or PAGE.
+	DataCompression string
+	// Online Keep the table available while the index is built, it needs the Enterprise edition or Azure SQL.
+	Online bool
+	// FileGroup The filegroup that stores the index.
+	FileGroup string
+}
+
+// IndexDetail The details of the index that GetIndexes of the schema has no room for, it only reports the kind of the
+// index in the type, such as clustered or nonclustered.
+type IndexDetail struct {
+	// Name The name of the index, lowered the same as GetIndexes.
+	Name string
+	// Descending The key columns sorted in descending order.
+	Descending []string
+	// Include The non-key columns stored in the leaf level of the index.
+	Include []string
+	// Where The predicate of the filtered index, such as ([deleted_at] IS NULL).
+	Where string
+}
+
+// DBIndexDetail The row of the index details, the columns are separated by commas.
+type DBIndexDetail struct {
+	Name       string
+	Descending string
+	Include    string
+	Where      string
+}
+
+// TableIndex Set the SQL Server options of the index, the unique index or the primary key that was added to the
+// blueprint last.
+//
+//	table.Index("customer_id", "created_at")
+//	sqlserver.TableIndex(table, sqlserver.Index{
+//		Descending: []string{"created_at"},
+//		Include:    []string{"total"},
+//		Where:      "[deleted_at] is null",
+//	})
+func TableIndex(blueprint contractsschema.Blueprint, options Index) {
+	commands := blueprint.GetCommands()
+	for i := len(commands) - 1; i >= 0; i-- {
+		switch commands[i].Name {
+		case schema.CommandIndex, schema.CommandUnique, schema.CommandPrimary:
+			setCommandOptions(commands[i], func(extra *extraOptions) {
+				extra.index = &options
+			})
+
+			return
+		}
+	}
+}
+
+// TableColumnstoreIndex Create the clustered columnstore index of the table, or the nonclustered columnstore index of
+// the columns. It's named <table>_columnstore, and dropped by DropColumnstoreIndex.
+//
+//	sqlserver.TableColumnstoreIndex(table, sqlserver.ColumnstoreIndex{Clustered: true, Order: []string{"sold_at"}})
+//	sqlserver.TableColumnstoreIndex(table, sqlserver.ColumnstoreIndex{}, "product_id", "quantity", "total")
+func TableColumnstoreIndex(blueprint contractsschema.Blueprint, options ColumnstoreIndex, columns ...string) contractsschema.IndexDefinition {
+	definition := blueprint.Index(columns...).Name(strings.ReplaceAll(blueprint.GetTableName(), ".", "_") + "_columnstore")
+	commands := blueprint.GetCommands()
+	setCommandOptions(commands[len(commands)-1], func(extra *extraOptions) {
+		extra.columnstore = &options
+	})
+
+	return definition
+}
+
+// DropColumnstoreIndex Drop the columnstore index of the table, whatever its name is.
+func DropColumnstoreIndex(blueprint contractsschema.Blueprint) {
+	blueprint.DropIndexByName("")
+	commands := blueprint.GetCommands()
+	setCommandOptions(commands[len(commands)-1], func(extra *extraOptions) {
+		extra.columnstoreDrop = true
+	})
+}
+
+// GetIndexDetails Get the descending key columns, the included columns and the filter of the indexes of the table.
+func (r *Sqlserver) GetIndexDetails(orm contractsorm.Orm, table string) ([]IndexDetail, error) {
+	sql, err := r.grammar().CompileIndexDetails("", table)
+	if err != nil {
+		return nil, err
+	}
+
+	var dbIndexDetails []DBIndexDetail
+	if err := orm.Query().Raw(sql).Scan(&dbIndexDetails); err != nil {
+		return nil, err
+	}
+
+	return NewProcessor().ProcessIndexDetails(dbIndexDetails), nil
+}
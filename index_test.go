@@ -0,0 +1,15 @@
+package sqlserver
+
+import (
+	"testing"
+
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
+)
+
+func TestGetIndexDetails(t *testing.T) {
+	assertDriverError(t, func(sqlserver *Sqlserver, orm contractsorm.Orm) error {
+		_, err := sqlserver.GetIndexDetails(orm, "users")
+
+		return err
+	})
+}
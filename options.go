@@ -0,0 +1,115 @@
+package sqlserver
+
+import (
+	"github.com/goravel/framework/contracts/database/driver"
+	contractsschema "github.com/goravel/framework/contracts/database/schema"
+	"github.com/goravel/framework/database/schema"
+)
+
+// commandTableOptions The skipped command that carries the options of the table, such as the collation set by
+// TableCollation.
+const commandTableOptions = "sqlserverTableOptions"
+
+// extraOptions The SQL Server options that the blueprint of the framework has no room for, such as the collation of
+// the columns and the options of the indexes. They're carried by the column or the command they're set on, so they go
+// away with the blueprint: a column keeps them as its on update value, which is only used by MySQL, and a command
+// keeps them in a column definition of its own.
+type extraOptions struct {
+	collation        string
+	computed         *computed
+	identity         *identity
+	srid             *int
+	index            *Index
+	columnstore      *ColumnstoreIndex
+	columnstoreDrop  bool
+	spatial          *SpatialIndex
+	systemVersioning *SystemVersioning
+}
+
+// columnOptions Get the options set on the column, the zero options when there are none. Only the column definitions
+// of the framework carry the options.
+func columnOptions(column driver.ColumnDefinition) extraOptions {
+	if definition, ok := column.(*schema.ColumnDefinition); ok {
+		if value, ok := definition.GetOnUpdate().(*extraOptions); ok {
+			return *value
+		}
+	}
+
+	return extraOptions{}
+}
+
+// commandOptions Get the options set on the command, they're the options of the column for the column commands.
+func commandOptions(command *driver.Command) extraOptions {
+	return columnOptions(command.Column)
+}
+
+// createOptions Get the options set on the create command of the blueprint, such as the system versioning.
+func createOptions(blueprint driver.Blueprint) extraOptions {
+	return namedCommandOptions(blueprint, schema.CommandCreate)
+}
+
+// tableOptions Get the options set on the table by TableCollation.
+func tableOptions(blueprint driver.Blueprint) extraOptions {
+	return namedCommandOptions(blueprint, commandTableOptions)
+}
+
+// namedCommandOptions Get the options set on the first command of the name, only the blueprints of the framework
+// carry the options.
+func namedCommandOptions(blueprint driver.Blueprint, name string) extraOptions {
+	if _, ok := blueprint.(*schema.Blueprint); !ok {
+		return extraOptions{}
+	}
+
+	for _, command := range blueprint.GetCommands() {
+		if command.Name == name {
+			return commandOptions(command)
+		}
+	}
+
+	return extraOptions{}
+}
+
+// setColumnOptions Update the options of the column.
+func setColumnOptions(column driver.ColumnDefinition, update func(*extraOptions)) {
+	definition, ok := column.(*schema.ColumnDefinition)
+	if !ok {
+		return
+	}
+
+	value, ok := definition.GetOnUpdate().(*extraOptions)
+	if !ok {
+		value = &extraOptions{}
+		definition.OnUpdate(value)
+	}
+
+	update(value)
+}
+
+// setCommandOptions Update the options of the command, a column definition is added to the command to carry them.
+func setCommandOptions(command *driver.Command, update func(*extraOptions)) {
+	if command.Column == nil {
+		command.Column = &schema.ColumnDefinition{}
+	}
+
+	setColumnOptions(command.Column, update)
+}
+
+// setTableOptions Update the options of the table, they're carried by a skipped command of the blueprint. The
+// blueprint has no method that adds a command of any name, so a table comment is added and turned into it.
+func setTableOptions(blueprint contractsschema.Blueprint, update func(*extraOptions)) {
+	for _, command := range blueprint.GetCommands() {
+		if command.Name == commandTableOptions {
+			setCommandOptions(command, update)
+
+			return
+		}
+	}
+
+	blueprint.Comment("")
+	commands := blueprint.GetCommands()
+	command := commands[len(commands)-1]
+	command.Name = commandTableOptions
+	command.ShouldBeSkipped = true
+
+	setCommandOptions(command, update)
+}
@@ -0,0 +1,40 @@
+package sqlserver
+
+import (
+	"testing"
+
+	"github.com/goravel/framework/database/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptions(t *testing.T) {
+	grammar := NewGrammar("goravel_")
+
+	other := schema.NewBlueprint(nil, "", "posts")
+	other.Create()
+	TableCollation(other, "Latin1_General_100_CI_AS")
+	other.String("title")
+
+	blueprint := schema.NewBlueprint(nil, "", "users")
+	blueprint.Create()
+	TableCollation(blueprint, "Japanese_CI_AS")
+	TableCollation(blueprint, "Japanese_XJIS_140_CI_AS")
+	Identity(blueprint.Integer("number"), 1000, 10)
+	Collation(blueprint.String("name"), "Latin1_General_100_CI_AS_SC_UTF8").Nullable()
+	blueprint.String("email")
+	blueprint.Index("name")
+	TableIndex(blueprint, Index{FillFactor: 80})
+
+	statements, err := blueprint.ToSql(grammar)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		`create table "goravel_users" ("number" int identity(1000, 10) not null, "name" varchar(255) collate Latin1_General_100_CI_AS_SC_UTF8 null, "email" nvarchar(255) collate Japanese_XJIS_140_CI_AS not null)`,
+		`create index "users_name_index" on "goravel_users" ("name") with (fillfactor = 80)`,
+	}, statements)
+
+	statements, err = other.ToSql(grammar)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		`create table "goravel_posts" ("title" nvarchar(255) collate Latin1_General_100_CI_AS not null)`,
+	}, statements)
+}
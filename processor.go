@@ -25,6 +25,7 @@ func (r Processor) ProcessColumns(dbColumns []driver.DBColumn) []driver.Column {
 			Collation:     dbColumn.Collation,
 			Comment:       dbColumn.Comment,
 			Default:       dbColumn.Default,
+			Extra:         dbColumn.Extra,
 			Name:          dbColumn.Name,
 			Nullable:      cast.ToBool(dbColumn.Nullable),
 			Type:          getType(dbColumn),
@@ -52,6 +53,21 @@ func (r Processor) ProcessForeignKeys(dbForeignKeys []driver.DBForeignKey) []dri
 	return foreignKeys
 }
 
+// ProcessIndexDetails Process the rows of CompileIndexDetails, the names are lowered the same as ProcessIndexes.
+func (r Processor) ProcessIndexDetails(dbIndexDetails []DBIndexDetail) []IndexDetail {
+	var indexDetails []IndexDetail
+	for _, dbIndexDetail := range dbIndexDetails {
+		indexDetails = append(indexDetails, IndexDetail{
+			Name:       strings.ToLower(dbIndexDetail.Name),
+			Descending: splitColumns(dbIndexDetail.Descending),
+			Include:    splitColumns(dbIndexDetail.Include),
+			Where:      dbIndexDetail.Where,
+		})
+	}
+
+	return indexDetails
+}
+
 func (r Processor) ProcessIndexes(dbIndexes []driver.DBIndex) []driver.Index {
 	var indexes []driver.Index
 	for _, dbIndex := range dbIndexes {
@@ -68,7 +84,40 @@ func (r Processor) ProcessIndexes(dbIndexes []driver.DBIndex) []driver.Index {
 }
 
 func (r Processor) ProcessTypes(types []driver.Type) []driver.Type {
-	return types
+	processedTypes := make([]driver.Type, len(types))
+	for i, t := range types {
+		t.Category = getTypeCategory(t.Category)
+		processedTypes[i] = t
+	}
+
+	return processedTypes
+}
+
+// getTypeCategory Get the category of the user-defined type by its system type, the categories are the same as the
+// ones of PostgreSQL.
+func getTypeCategory(systemType string) string {
+	switch systemType {
+	case "table":
+		return "composite"
+	case "bigint", "int", "smallint", "tinyint", "decimal", "numeric", "float", "real", "money", "smallmoney":
+		return "numeric"
+	case "char", "varchar", "nchar", "nvarchar", "text", "ntext", "sysname":
+		return "string"
+	case "date", "datetime", "datetime2", "datetimeoffset", "smalldatetime", "time":
+		return "date"
+	case "bit":
+		return "boolean"
+	default:
+		return "user_defined"
+	}
+}
+
+func splitColumns(columns string) []string {
+	if columns == "" {
+		return nil
+	}
+
+	return strings.Split(columns, ",")
 }
 
 func getType(dbColumn driver.DBColumn) string {
@@ -39,6 +39,33 @@ func (s *ProcessorTestSuite) TestProcessColumns() {
 				{Autoincrement: false, Collation: "utf8_general_ci", Comment: "user name", Default: "default_name", Name: "name", Nullable: true, Type: "varchar(10)", TypeName: "varchar"},
 			},
 		},
+		{
+			name: "Collation",
+			dbColumns: []driver.DBColumn{
+				{Name: "name", TypeName: "varchar", Nullable: "false", Collation: "Latin1_General_100_CI_AS_SC_UTF8", Length: 100},
+			},
+			expected: []driver.Column{
+				{Collation: "Latin1_General_100_CI_AS_SC_UTF8", Name: "name", Type: "varchar(100)", TypeName: "varchar"},
+			},
+		},
+		{
+			name: "ComputedColumn",
+			dbColumns: []driver.DBColumn{
+				{Name: "total", TypeName: "decimal", Nullable: "false", Extra: "as ([price]*[quantity]) persisted", Precision: 18, Places: 2},
+			},
+			expected: []driver.Column{
+				{Extra: "as ([price]*[quantity]) persisted", Name: "total", Type: "decimal(18,2)", TypeName: "decimal"},
+			},
+		},
+		{
+			name: "IdentityColumn",
+			dbColumns: []driver.DBColumn{
+				{Name: "id", TypeName: "bigint", Nullable: "false", Autoincrement: true, Extra: "identity(1000, 10) last_value 1020"},
+			},
+			expected: []driver.Column{
+				{Autoincrement: true, Extra: "identity(1000, 10) last_value 1020", Name: "id", Type: "bigint", TypeName: "bigint"},
+			},
+		},
 		{
 			name:      "EmptyInput",
 			dbColumns: []driver.DBColumn{},
@@ -100,6 +127,42 @@ func (s *ProcessorTestSuite) TestProcessForeignKeys() {
 	}
 }
 
+func (s *ProcessorTestSuite) TestProcessIndexes() {
+	s.Equal([]driver.Index{
+		{Name: "pk_users", Type: "clustered", Columns: []string{"id"}, Primary: true, Unique: true},
+		{Name: "users_name_email_index", Type: "nonclustered", Columns: []string{"name", "email"}},
+		{Name: "users_columnstore", Type: "nonclustered columnstore", Columns: []string{"name", "age"}},
+	}, s.processor.ProcessIndexes([]driver.DBIndex{
+		{Name: "PK_users", Type: "CLUSTERED", Columns: "id", Primary: true, Unique: true},
+		{Name: "users_name_email_index", Type: "NONCLUSTERED", Columns: "name,email"},
+		{Name: "users_columnstore", Type: "NONCLUSTERED COLUMNSTORE", Columns: "name,age"},
+	}))
+}
+
+func (s *ProcessorTestSuite) TestProcessIndexDetails() {
+	s.Equal([]IndexDetail{
+		{Name: "pk_users"},
+		{Name: "users_name_email_index", Descending: []string{"email"}, Include: []string{"Age", "status"}, Where: "([Deleted_At] IS NULL)"},
+	}, s.processor.ProcessIndexDetails([]DBIndexDetail{
+		{Name: "PK_users"},
+		{Name: "users_name_email_index", Descending: "email", Include: "Age,status", Where: "([Deleted_At] IS NULL)"},
+	}))
+}
+
+func (s *ProcessorTestSuite) TestProcessTypes() {
+	s.Equal([]driver.Type{
+		{Name: "Email", Schema: "dbo", Type: "alias", Category: "string"},
+		{Name: "Amount", Schema: "dbo", Type: "alias", Category: "numeric"},
+		{Name: "OrderLines", Schema: "dbo", Type: "table", Category: "composite"},
+		{Name: "Hash", Schema: "dbo", Type: "alias", Category: "user_defined"},
+	}, s.processor.ProcessTypes([]driver.Type{
+		{Name: "Email", Schema: "dbo", Type: "alias", Category: "nvarchar"},
+		{Name: "Amount", Schema: "dbo", Type: "alias", Category: "decimal"},
+		{Name: "OrderLines", Schema: "dbo", Type: "table", Category: "table"},
+		{Name: "Hash", Schema: "dbo", Type: "alias", Category: "varbinary"},
+	}))
+}
+
 func TestGetType(t *testing.T) {
 	tests := []struct {
 		name     string
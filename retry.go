@@ -0,0 +1,371 @@
+package sqlserver
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/goravel/sqlserver/contracts"
+)
+
+const (
+	defaultInitialBackoff = time.Second
+	defaultMaxBackoff     = 30 * time.Second
+)
+
+// transientErrors The errors raised while Azure SQL reconfigures a database or an Availability Group fails over,
+// they go away after a few seconds.
+var transientErrors = []int32{
+	4060,  // Cannot open database requested by the login
+	10928, // Resource limit reached
+	40501, // The service is currently busy
+	40613, // Database is not currently available
+	49918, // Not enough resources to process the request
+}
+
+var (
+	_ driver.Connector          = &retryConnector{}
+	_ driver.ConnBeginTx        = &retryConn{}
+	_ driver.ConnPrepareContext = &retryConn{}
+	_ driver.NamedValueChecker  = &retryConn{}
+	_ driver.Pinger             = &retryConn{}
+	_ driver.SessionResetter    = &retryConn{}
+	_ driver.Validator          = &retryConn{}
+	_ driver.StmtExecContext    = &retryStmt{}
+	_ driver.StmtQueryContext   = &retryStmt{}
+)
+
+// retryConnector Retries opening connections and idempotent statements that fail with a transient error.
+type retryConnector struct {
+	connector driver.Connector
+	retry     contracts.Retry
+}
+
+func newRetryConnector(connector driver.Connector, retry contracts.Retry) *retryConnector {
+	return &retryConnector{
+		connector: connector,
+		retry:     retry,
+	}
+}
+
+func (r *retryConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	var conn driver.Conn
+	if err := r.do(ctx, func() (err error) {
+		conn, err = r.connector.Connect(ctx)
+
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return &retryConn{Conn: conn, connector: r}, nil
+}
+
+func (r *retryConnector) Driver() driver.Driver {
+	return r.connector.Driver()
+}
+
+func (r *retryConnector) do(ctx context.Context, fn func() error) error {
+	return doWithRetry(ctx, r.retry, fn, nil)
+}
+
+type retryConn struct {
+	driver.Conn
+	connector *retryConnector
+	inTx      bool
+}
+
+func (r *retryConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	var (
+		tx  driver.Tx
+		err error
+	)
+	if beginner, ok := r.Conn.(driver.ConnBeginTx); ok {
+		tx, err = beginner.BeginTx(ctx, opts)
+	} else {
+		tx, err = r.Conn.Begin()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	r.inTx = true
+
+	return &retryTx{Tx: tx, conn: r}, nil
+}
+
+func (r *retryConn) CheckNamedValue(value *driver.NamedValue) error {
+	if checker, ok := r.Conn.(driver.NamedValueChecker); ok {
+		return checker.CheckNamedValue(value)
+	}
+
+	return driver.ErrSkip
+}
+
+func (r *retryConn) IsValid() bool {
+	if validator, ok := r.Conn.(driver.Validator); ok {
+		return validator.IsValid()
+	}
+
+	return true
+}
+
+func (r *retryConn) Ping(ctx context.Context) error {
+	if pinger, ok := r.Conn.(driver.Pinger); ok {
+		return pinger.Ping(ctx)
+	}
+
+	return nil
+}
+
+func (r *retryConn) Prepare(query string) (driver.Stmt, error) {
+	return r.PrepareContext(context.Background(), query)
+}
+
+func (r *retryConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	stmt, err := prepare(ctx, r.Conn, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &retryStmt{Stmt: stmt, conn: r, preparedOn: r.Conn, query: query, idempotent: isIdempotent(query)}, nil
+}
+
+// Unwrap Returns the connection of the driver, such as *mssql.Conn.
+func (r *retryConn) Unwrap() driver.Conn {
+	return r.Conn
+}
+
+// reconnect Replaces the broken connection by a new one, the connector retries opening it.
+func (r *retryConn) reconnect(ctx context.Context) error {
+	conn, err := r.connector.connector.Connect(ctx)
+	if err != nil {
+		return err
+	}
+
+	_ = r.Conn.Close()
+	r.Conn = conn
+
+	return nil
+}
+
+func (r *retryConn) ResetSession(ctx context.Context) error {
+	if resetter, ok := r.Conn.(driver.SessionResetter); ok {
+		return resetter.ResetSession(ctx)
+	}
+
+	return nil
+}
+
+type retryTx struct {
+	driver.Tx
+	conn *retryConn
+}
+
+func (r *retryTx) Commit() error {
+	r.conn.inTx = false
+
+	return r.Tx.Commit()
+}
+
+func (r *retryTx) Rollback() error {
+	r.conn.inTx = false
+
+	return r.Tx.Rollback()
+}
+
+type retryStmt struct {
+	driver.Stmt
+	conn       *retryConn
+	preparedOn driver.Conn
+	query      string
+	idempotent bool
+}
+
+func (r *retryStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	if execer, ok := r.Stmt.(driver.StmtExecContext); ok {
+		return execer.ExecContext(ctx, args)
+	}
+
+	return r.Stmt.Exec(namedValuesToValues(args))
+}
+
+func (r *retryStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	// A statement can't be retried in a transaction, the transaction is rolled back by the error.
+	if !r.idempotent || r.conn.inTx {
+		return r.queryContext(ctx, args)
+	}
+
+	var rows driver.Rows
+	err := doWithRetry(ctx, r.conn.connector.retry, func() (err error) {
+		// The connection is usually broken by the transient error, so the statement is prepared again on a new one.
+		if !r.conn.IsValid() {
+			if err := r.conn.reconnect(ctx); err != nil {
+				return err
+			}
+		}
+		if r.preparedOn != r.conn.Conn {
+			if err := r.reprepare(ctx); err != nil {
+				return err
+			}
+		}
+
+		rows, err = r.queryContext(ctx, args)
+
+		return err
+	}, nil)
+
+	return rows, err
+}
+
+func (r *retryStmt) queryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	if queryer, ok := r.Stmt.(driver.StmtQueryContext); ok {
+		return queryer.QueryContext(ctx, args)
+	}
+
+	return r.Stmt.Query(namedValuesToValues(args))
+}
+
+func (r *retryStmt) reprepare(ctx context.Context) error {
+	stmt, err := prepare(ctx, r.conn.Conn, r.query)
+	if err != nil {
+		return err
+	}
+
+	_ = r.Stmt.Close()
+	r.Stmt = stmt
+	r.preparedOn = r.conn.Conn
+
+	return nil
+}
+
+// UnwrapConn Returns the connection of go-mssqldb passed to sql.Conn.Raw, the connection is wrapped when the retry
+// is enabled. It's needed by the bulk copy of *mssql.Conn:
+//
+//	conn.Raw(func(driverConn any) error {
+//		mssqlConn := sqlserver.UnwrapConn(driverConn).(*mssql.Conn)
+//		...
+//	})
+func UnwrapConn(driverConn any) any {
+	if conn, ok := driverConn.(interface{ Unwrap() driver.Conn }); ok {
+		return conn.Unwrap()
+	}
+
+	return driverConn
+}
+
+// doWithRetry Runs fn until it succeeds, fails with an error that isn't in policy.Errors or reaches
+// policy.MaxAttempts, onRetry is called before waiting for the next attempt.
+func doWithRetry(ctx context.Context, policy contracts.Retry, fn func() error, onRetry func(attempt int, delay time.Duration, err error)) error {
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil || attempt >= policy.MaxAttempts || !isTransientError(err, policy.Errors) {
+			return err
+		}
+
+		delay := backoff(attempt, policy.InitialBackoff, policy.MaxBackoff)
+		if onRetry != nil {
+			onRetry(attempt, delay, err)
+		}
+
+		if err := sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// sleep Waits for the delay, returns the error of the context when it's done first.
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// backoff Returns the exponential backoff of the attempt with equal jitter.
+func backoff(attempt int, initialBackoff, maxBackoff time.Duration) time.Duration {
+	if initialBackoff <= 0 {
+		initialBackoff = defaultInitialBackoff
+	}
+	if maxBackoff <= 0 {
+		maxBackoff = defaultMaxBackoff
+	}
+
+	delay := initialBackoff << (attempt - 1)
+	if delay <= 0 || delay > maxBackoff {
+		delay = maxBackoff
+	}
+
+	return delay/2 + rand.N(delay/2+1)
+}
+
+// isIdempotent Reports whether the query is a SELECT statement, the leading comments and parentheses are skipped. A
+// query that starts with a CTE isn't idempotent, because the CTE may be followed by INSERT, UPDATE, DELETE or MERGE.
+func isIdempotent(query string) bool {
+	for {
+		query = strings.TrimLeft(query, " \t\r\n(")
+		switch {
+		case strings.HasPrefix(query, "--"):
+			end := strings.IndexByte(query, '\n')
+			if end < 0 {
+				return false
+			}
+			query = query[end+1:]
+		case strings.HasPrefix(query, "/*"):
+			end := strings.Index(query, "*/")
+			if end < 0 {
+				return false
+			}
+			query = query[end+2:]
+		default:
+			if len(query) < 6 || !strings.EqualFold(query[:6], "select") {
+				return false
+			}
+
+			return len(query) == 6 || !isIdentifierChar(rune(query[6]))
+		}
+	}
+}
+
+func isIdentifierChar(char rune) bool {
+	return char == '_' || char == '@' || char == '#' || char == '$' || unicode.IsLetter(char) || unicode.IsDigit(char)
+}
+
+func isTransientError(err error, numbers []int32) bool {
+	var sqlErr interface{ SQLErrorNumber() int32 }
+	if !errors.As(err, &sqlErr) {
+		return false
+	}
+	if len(numbers) == 0 {
+		numbers = transientErrors
+	}
+
+	return slices.Contains(numbers, sqlErr.SQLErrorNumber())
+}
+
+func prepare(ctx context.Context, conn driver.Conn, query string) (driver.Stmt, error) {
+	if preparer, ok := conn.(driver.ConnPrepareContext); ok {
+		return preparer.PrepareContext(ctx, query)
+	}
+
+	return conn.Prepare(query)
+}
+
+func namedValuesToValues(args []driver.NamedValue) []driver.Value {
+	values := make([]driver.Value, len(args))
+	for i, arg := range args {
+		values[i] = arg.Value
+	}
+
+	return values
+}
@@ -0,0 +1,314 @@
+package sqlserver
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/sqlserver/contracts"
+)
+
+type fakeError struct {
+	number int32
+}
+
+func (r fakeError) Error() string {
+	return "fake error"
+}
+
+func (r fakeError) SQLErrorNumber() int32 {
+	return r.number
+}
+
+// fakeConnector Returns the errors in order before succeeding, both for connecting and for running statements. The
+// statement errors break the connection unless keepConn is set.
+type fakeConnector struct {
+	connectErrors []error
+	queryErrors   []error
+	keepConn      bool
+	connects      int
+	queries       int
+}
+
+func (r *fakeConnector) Connect(context.Context) (driver.Conn, error) {
+	r.connects++
+	if len(r.connectErrors) > 0 {
+		err := r.connectErrors[0]
+		r.connectErrors = r.connectErrors[1:]
+
+		return nil, err
+	}
+
+	return &fakeConn{connector: r}, nil
+}
+
+func (r *fakeConnector) Driver() driver.Driver {
+	return nil
+}
+
+type fakeConn struct {
+	connector *fakeConnector
+	broken    bool
+}
+
+func (r *fakeConn) Begin() (driver.Tx, error) {
+	return r, nil
+}
+
+func (r *fakeConn) Close() error {
+	return nil
+}
+
+func (r *fakeConn) Commit() error {
+	return nil
+}
+
+func (r *fakeConn) IsValid() bool {
+	return !r.broken
+}
+
+func (r *fakeConn) Prepare(string) (driver.Stmt, error) {
+	return &fakeStmt{conn: r}, nil
+}
+
+func (r *fakeConn) Rollback() error {
+	return nil
+}
+
+type fakeStmt struct {
+	conn *fakeConn
+}
+
+func (r *fakeStmt) Close() error {
+	return nil
+}
+
+func (r *fakeStmt) Exec([]driver.Value) (driver.Result, error) {
+	if err := r.next(); err != nil {
+		return nil, err
+	}
+
+	return driver.RowsAffected(1), nil
+}
+
+func (r *fakeStmt) NumInput() int {
+	return -1
+}
+
+func (r *fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	if err := r.next(); err != nil {
+		return nil, err
+	}
+
+	return &fakeRows{}, nil
+}
+
+func (r *fakeStmt) next() error {
+	if r.conn.broken {
+		return driver.ErrBadConn
+	}
+
+	connector := r.conn.connector
+	connector.queries++
+	if len(connector.queryErrors) > 0 {
+		err := connector.queryErrors[0]
+		connector.queryErrors = connector.queryErrors[1:]
+		r.conn.broken = !connector.keepConn
+
+		return err
+	}
+
+	return nil
+}
+
+type fakeRows struct{}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Columns() []string {
+	return nil
+}
+
+func (r *fakeRows) Next([]driver.Value) error {
+	return io.EOF
+}
+
+func TestRetryConnector(t *testing.T) {
+	retry := contracts.Retry{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Millisecond,
+	}
+
+	t.Run("retry connecting on transient errors", func(t *testing.T) {
+		connector := &fakeConnector{connectErrors: []error{fakeError{40613}, fakeError{40501}}}
+		db := sql.OpenDB(newRetryConnector(connector, retry))
+		defer db.Close()
+
+		assert.NoError(t, db.Ping())
+		assert.Equal(t, 3, connector.connects)
+	})
+
+	t.Run("stop connecting after max attempts", func(t *testing.T) {
+		connector := &fakeConnector{connectErrors: []error{fakeError{40613}, fakeError{40613}, fakeError{40613}, fakeError{40613}}}
+		db := sql.OpenDB(newRetryConnector(connector, retry))
+		defer db.Close()
+
+		assert.Equal(t, fakeError{40613}, db.Ping())
+		assert.Equal(t, 3, connector.connects)
+	})
+
+	t.Run("don't retry other errors", func(t *testing.T) {
+		connector := &fakeConnector{connectErrors: []error{fakeError{18456}}}
+		db := sql.OpenDB(newRetryConnector(connector, retry))
+		defer db.Close()
+
+		assert.Equal(t, fakeError{18456}, db.Ping())
+		assert.Equal(t, 1, connector.connects)
+	})
+
+	t.Run("retry the configured errors", func(t *testing.T) {
+		connector := &fakeConnector{connectErrors: []error{fakeError{1205}}}
+		db := sql.OpenDB(newRetryConnector(connector, contracts.Retry{
+			MaxAttempts:    2,
+			InitialBackoff: time.Millisecond,
+			Errors:         []int32{1205},
+		}))
+		defer db.Close()
+
+		assert.NoError(t, db.Ping())
+		assert.Equal(t, 2, connector.connects)
+	})
+
+	t.Run("retry select statements", func(t *testing.T) {
+		connector := &fakeConnector{queryErrors: []error{fakeError{40501}}}
+		db := sql.OpenDB(newRetryConnector(connector, retry))
+		defer db.Close()
+
+		rows, err := db.Query(" select 1")
+		assert.NoError(t, err)
+		assert.NoError(t, rows.Close())
+		assert.Equal(t, 2, connector.queries)
+		assert.Equal(t, 2, connector.connects)
+	})
+
+	t.Run("retry select statements on the same connection when it isn't broken", func(t *testing.T) {
+		connector := &fakeConnector{queryErrors: []error{fakeError{40501}}, keepConn: true}
+		db := sql.OpenDB(newRetryConnector(connector, retry))
+		defer db.Close()
+
+		rows, err := db.Query("SELECT 1")
+		assert.NoError(t, err)
+		assert.NoError(t, rows.Close())
+		assert.Equal(t, 2, connector.queries)
+		assert.Equal(t, 1, connector.connects)
+	})
+
+	t.Run("stop retrying select statements after max attempts", func(t *testing.T) {
+		connector := &fakeConnector{queryErrors: []error{fakeError{40501}, fakeError{40501}, fakeError{40501}, fakeError{40501}, fakeError{40501}, fakeError{40501}}}
+		db := sql.OpenDB(newRetryConnector(connector, contracts.Retry{
+			MaxAttempts:    5,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     time.Millisecond,
+		}))
+		defer db.Close()
+
+		_, err := db.Query("SELECT 1")
+		assert.Equal(t, fakeError{40501}, err)
+		assert.Equal(t, 5, connector.queries)
+		assert.Equal(t, 5, connector.connects)
+	})
+
+	t.Run("unwrap the connection of the driver", func(t *testing.T) {
+		connector := &fakeConnector{}
+		db := sql.OpenDB(newRetryConnector(connector, retry))
+		defer db.Close()
+
+		conn, err := db.Conn(context.Background())
+		assert.NoError(t, err)
+		defer conn.Close()
+
+		assert.NoError(t, conn.Raw(func(driverConn any) error {
+			assert.IsType(t, &fakeConn{}, UnwrapConn(driverConn))
+
+			return nil
+		}))
+		assert.Equal(t, "conn", UnwrapConn("conn"))
+	})
+
+	t.Run("don't retry other statements", func(t *testing.T) {
+		connector := &fakeConnector{queryErrors: []error{fakeError{40501}, fakeError{40501}}}
+		db := sql.OpenDB(newRetryConnector(connector, retry))
+		defer db.Close()
+
+		_, err := db.Exec("UPDATE users SET name = 'goravel'")
+		assert.Equal(t, fakeError{40501}, err)
+
+		_, err = db.Query("DELETE FROM users OUTPUT deleted.id")
+		assert.Equal(t, fakeError{40501}, err)
+		assert.Equal(t, 2, connector.queries)
+	})
+
+	t.Run("don't retry statements in transactions", func(t *testing.T) {
+		connector := &fakeConnector{queryErrors: []error{fakeError{40501}}}
+		db := sql.OpenDB(newRetryConnector(connector, retry))
+		defer db.Close()
+
+		tx, err := db.Begin()
+		assert.NoError(t, err)
+
+		_, err = tx.Query("SELECT 1")
+		assert.Equal(t, fakeError{40501}, err)
+		assert.Equal(t, 1, connector.queries)
+		assert.NoError(t, tx.Rollback())
+	})
+
+	t.Run("stop waiting when the context is done", func(t *testing.T) {
+		connector := &fakeConnector{connectErrors: []error{fakeError{40613}}}
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := newRetryConnector(connector, contracts.Retry{MaxAttempts: 3, InitialBackoff: time.Hour}).Connect(ctx)
+		assert.True(t, errors.Is(err, context.Canceled))
+	})
+}
+
+func TestIsIdempotent(t *testing.T) {
+	for query, expected := range map[string]bool{
+		"select 1":                                 true,
+		" SELECT * FROM users":                     true,
+		"select*from users":                        true,
+		"(select 1) union (select 2)":              true,
+		"-- users\nselect * from users":            true,
+		"/* users */ select * from users":          true,
+		"selected":                                 false,
+		"-- select":                                false,
+		"/* select":                                false,
+		"with cte as (select 1) select * from cte": false,
+		"update users set name = 'goravel'":        false,
+		"delete from users output deleted.id":      false,
+	} {
+		assert.Equal(t, expected, isIdempotent(query), query)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	for attempt, expected := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second} {
+		delay := backoff(attempt+1, time.Second, 5*time.Second)
+
+		assert.GreaterOrEqual(t, delay, expected/2)
+		assert.LessOrEqual(t, delay, expected)
+	}
+
+	delay := backoff(100, 0, 0)
+	assert.GreaterOrEqual(t, delay, defaultMaxBackoff/2)
+	assert.LessOrEqual(t, delay, defaultMaxBackoff)
+}
@@ -0,0 +1,153 @@
+package sqlserver
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"maps"
+	"reflect"
+	"sync"
+
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
+	"github.com/goravel/framework/support/carbon"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+// RowVersion The rowversion of the row, SQL Server changes it whenever the row is inserted or updated. The column is
+// created by the rowVersion type, and the field is read-only for gorm:
+//
+//	table.Column("row_version", "rowVersion")
+//
+//	type User struct {
+//		orm.Model
+//		Name       string
+//		RowVersion sqlserver.RowVersion `gorm:"->"`
+//	}
+type RowVersion [8]byte
+
+func (v *RowVersion) Scan(src any) error {
+	switch value := src.(type) {
+	case nil:
+		*v = RowVersion{}
+
+		return nil
+	case []byte:
+		if len(value) != len(v) {
+			return InvalidRowVersion.Args(value)
+		}
+		copy(v[:], value)
+
+		return nil
+	default:
+		return InvalidRowVersion.Args(src)
+	}
+}
+
+func (v RowVersion) Value() (driver.Value, error) {
+	return v[:], nil
+}
+
+// IsZero The row version of the model that isn't read from the database yet.
+func (v RowVersion) IsZero() bool {
+	return v == RowVersion{}
+}
+
+// String The hexadecimal literal of the row version, the same as SQL Server shows it.
+func (v RowVersion) String() string {
+	return fmt.Sprintf("0x%X", v[:])
+}
+
+// UpdateWithRowVersion Update the columns of the model, keyed by the column names, only when the row still has the row
+// version of the model, then copy the values to the fields of the model and refresh its row version by the one output
+// by the update. The auto update time field, such as updated_at, is set the same as gorm does, but the hooks and the
+// events of the model aren't run. The expressions, such as gorm.Expr, aren't copied. RowVersionConflict is returned
+// when the row was changed or deleted since the model was read.
+//
+//	err := driver.UpdateWithRowVersion(facades.Orm(), &user, map[string]any{"name": "goravel"})
+//	if errors.Is(err, sqlserver.RowVersionConflict) {
+//		// Read the row again and retry, or report the conflict.
+//	}
+func (r *Sqlserver) UpdateWithRowVersion(orm contractsorm.Orm, model any, values map[string]any) error {
+	config := r.config.Writers()[0]
+	modelSchema, err := schema.Parse(model, &sync.Map{}, schema.NamingStrategy{
+		TablePrefix:   config.Prefix,
+		SingularTable: config.Singular,
+		NoLowerCase:   config.NoLowerCase,
+		NameReplacer:  config.NameReplacer,
+	})
+	if err != nil {
+		return err
+	}
+	if len(values) == 0 {
+		return NoValuesToUpdate.Args(modelSchema.Name)
+	}
+
+	primaryField := modelSchema.PrioritizedPrimaryField
+	if primaryField == nil {
+		return PrimaryKeyNotFound.Args(modelSchema.Name)
+	}
+
+	var versionField *schema.Field
+	for _, field := range modelSchema.Fields {
+		if field.FieldType == reflect.TypeOf(RowVersion{}) {
+			versionField = field
+			break
+		}
+	}
+	if versionField == nil {
+		return RowVersionNotFound.Args(modelSchema.Name)
+	}
+
+	values = maps.Clone(values)
+	now := carbon.Now().StdTime()
+	for _, field := range modelSchema.Fields {
+		if field.AutoUpdateTime == 0 || field.DBName == "" {
+			continue
+		}
+		if _, ok := values[field.DBName]; ok {
+			continue
+		}
+
+		switch field.AutoUpdateTime {
+		case schema.UnixNanosecond:
+			values[field.DBName] = now.UnixNano()
+		case schema.UnixMillisecond:
+			values[field.DBName] = now.UnixMilli()
+		case schema.UnixSecond:
+			values[field.DBName] = now.Unix()
+		default:
+			values[field.DBName] = now
+		}
+	}
+
+	ctx := context.Background()
+	modelValue := reflect.Indirect(reflect.ValueOf(model))
+	key, _ := primaryField.ValueOf(ctx, modelValue)
+	version, _ := versionField.ValueOf(ctx, modelValue)
+
+	sql, args := r.grammar().CompileUpdateWithRowVersion(modelSchema.Table, primaryField.DBName, versionField.DBName, values)
+
+	var versions []RowVersion
+	if err := orm.Query().Raw(sql, append(args, key, version)...).Scan(&versions); err != nil {
+		return err
+	}
+	if len(versions) == 0 {
+		return RowVersionConflict
+	}
+
+	for name, value := range values {
+		field := modelSchema.LookUpField(name)
+		if field == nil {
+			continue
+		}
+		if _, ok := value.(clause.Expression); ok {
+			continue
+		}
+		if err := field.Set(ctx, modelValue, value); err != nil {
+			return err
+		}
+	}
+
+	return versionField.Set(ctx, modelValue, versions[0])
+}
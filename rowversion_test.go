@@ -0,0 +1,143 @@
+package sqlserver
+
+import (
+	"testing"
+
+	"github.com/goravel/framework/database/orm"
+	mocksorm "github.com/goravel/framework/mocks/database/orm"
+	"github.com/goravel/framework/support/carbon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goravel/sqlserver/contracts"
+	mocks "github.com/goravel/sqlserver/mocks"
+)
+
+type rowVersionUser struct {
+	ID         uint
+	Name       string
+	RowVersion RowVersion `gorm:"->"`
+}
+
+func TestRowVersion(t *testing.T) {
+	var version RowVersion
+
+	assert.True(t, version.IsZero())
+	assert.NoError(t, version.Scan([]byte{0, 0, 0, 0, 0, 0, 0x07, 0xD1}))
+	assert.False(t, version.IsZero())
+	assert.Equal(t, "0x00000000000007D1", version.String())
+
+	value, err := version.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0x07, 0xD1}, value)
+
+	assert.Equal(t, InvalidRowVersion.Args([]byte{1}), version.Scan([]byte{1}))
+	assert.Equal(t, InvalidRowVersion.Args("1"), version.Scan("1"))
+	assert.NoError(t, version.Scan(nil))
+	assert.True(t, version.IsZero())
+}
+
+func TestUpdateWithRowVersion(t *testing.T) {
+	var (
+		mockConfig *mocks.ConfigBuilder
+		mockOrm    *mocksorm.Orm
+		mockQuery  *mocksorm.Query
+	)
+
+	oldVersion := RowVersion{0, 0, 0, 0, 0, 0, 0x07, 0xD1}
+	newVersion := RowVersion{0, 0, 0, 0, 0, 0, 0x07, 0xD2}
+
+	beforeEach := func() {
+		mockConfig = mocks.NewConfigBuilder(t)
+		mockOrm = mocksorm.NewOrm(t)
+		mockQuery = mocksorm.NewQuery(t)
+		mockConfig.EXPECT().Writers().Return([]contracts.FullConfig{{Prefix: "goravel_"}})
+	}
+
+	t.Run("updated", func(t *testing.T) {
+		beforeEach()
+		mockOrm.EXPECT().Query().Return(mockQuery).Once()
+		mockQuery.EXPECT().Raw(mock.Anything, "goravel", uint(1), oldVersion).Return(mockQuery).Once()
+		mockQuery.EXPECT().Scan(mock.Anything).RunAndReturn(func(dest any) error {
+			*dest.(*[]RowVersion) = []RowVersion{newVersion}
+
+			return nil
+		}).Once()
+
+		user := rowVersionUser{ID: 1, Name: "hello", RowVersion: oldVersion}
+		assert.NoError(t, (&Sqlserver{config: mockConfig}).UpdateWithRowVersion(mockOrm, &user, map[string]any{"name": "goravel"}))
+		assert.Equal(t, "goravel", user.Name)
+		assert.Equal(t, newVersion, user.RowVersion)
+	})
+
+	t.Run("updated with the update time", func(t *testing.T) {
+		beforeEach()
+		now := carbon.Now()
+		carbon.SetTestNow(now)
+		defer carbon.ClearTestNow()
+
+		type Post struct {
+			orm.Model
+			Title      string
+			RowVersion RowVersion `gorm:"->"`
+		}
+
+		mockOrm.EXPECT().Query().Return(mockQuery).Once()
+		mockQuery.EXPECT().Raw(mock.Anything, "goravel", now.StdTime(), uint(1), oldVersion).Return(mockQuery).Once()
+		mockQuery.EXPECT().Scan(mock.Anything).RunAndReturn(func(dest any) error {
+			*dest.(*[]RowVersion) = []RowVersion{newVersion}
+
+			return nil
+		}).Once()
+
+		post := Post{Model: orm.Model{ID: 1}, Title: "hello", RowVersion: oldVersion}
+		values := map[string]any{"title": "goravel"}
+		assert.NoError(t, (&Sqlserver{config: mockConfig}).UpdateWithRowVersion(mockOrm, &post, values))
+		assert.Equal(t, map[string]any{"title": "goravel"}, values)
+		assert.Equal(t, "goravel", post.Title)
+		assert.NotNil(t, post.UpdatedAt)
+		assert.True(t, now.StdTime().Equal(post.UpdatedAt.StdTime()))
+		assert.Equal(t, newVersion, post.RowVersion)
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		beforeEach()
+		mockOrm.EXPECT().Query().Return(mockQuery).Once()
+		mockQuery.EXPECT().Raw(mock.Anything, "goravel", uint(1), oldVersion).Return(mockQuery).Once()
+		mockQuery.EXPECT().Scan(mock.Anything).Return(nil).Once()
+
+		user := rowVersionUser{ID: 1, Name: "hello", RowVersion: oldVersion}
+		assert.ErrorIs(t, (&Sqlserver{config: mockConfig}).UpdateWithRowVersion(mockOrm, &user, map[string]any{"name": "goravel"}), RowVersionConflict)
+		assert.Equal(t, "hello", user.Name)
+		assert.Equal(t, oldVersion, user.RowVersion)
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		beforeEach()
+		mockOrm.EXPECT().Query().Return(mockQuery).Once()
+		mockQuery.EXPECT().Raw(mock.Anything, "goravel", uint(1), oldVersion).Return(mockQuery).Once()
+		mockQuery.EXPECT().Scan(mock.Anything).Return(assert.AnError).Once()
+
+		user := rowVersionUser{ID: 1, Name: "hello", RowVersion: oldVersion}
+		assert.Equal(t, assert.AnError, (&Sqlserver{config: mockConfig}).UpdateWithRowVersion(mockOrm, &user, map[string]any{"name": "goravel"}))
+		assert.Equal(t, "hello", user.Name)
+	})
+
+	t.Run("without values", func(t *testing.T) {
+		beforeEach()
+
+		user := rowVersionUser{ID: 1, Name: "hello", RowVersion: oldVersion}
+		assert.Equal(t, NoValuesToUpdate.Args("rowVersionUser"), (&Sqlserver{config: mockConfig}).UpdateWithRowVersion(mockOrm, &user, nil))
+	})
+
+	t.Run("without row version", func(t *testing.T) {
+		beforeEach()
+
+		type Post struct {
+			ID    uint
+			Title string
+		}
+
+		assert.Equal(t, RowVersionNotFound.Args("Post"), (&Sqlserver{config: mockConfig}).UpdateWithRowVersion(mockOrm, &Post{}, map[string]any{"title": "goravel"}))
+	})
+}
@@ -0,0 +1,92 @@
+package sqlserver
+
+import (
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
+)
+
+// Sequence The sequence object, the zero values keep the defaults of SQL Server.
+type Sequence struct {
+	// Name The name of the sequence, it can be qualified by the schema.
+	Name string
+	// Schema The schema of the sequence, the default schema is used when both it and the name aren't qualified.
+	Schema string
+	// Type The integer type of the sequence, it's bigint when empty.
+	Type string
+	// Start The first value, it restarts the sequence when altered.
+	Start *int64
+	// Increment The step of the values, it can be negative.
+	Increment int64
+	// MinValue The min value, it's the min value of the type when nil.
+	MinValue *int64
+	// MaxValue The max value, it's the max value of the type when nil.
+	MaxValue *int64
+	// Cycle Restart from the min value, or the max value when descending, after the last value.
+	Cycle bool
+	// Cache The number of values cached in memory, -1 means no cache.
+	Cache int64
+	// CurrentValue The last value, it's only set by GetSequences.
+	CurrentValue *int64
+}
+
+// SequenceDefault The default of the column that takes the next value of the sequence, the grammar qualifies the
+// sequence by the default schema when it isn't qualified.
+type SequenceDefault string
+
+// NextValueFor The default of the column that takes the next value of the sequence.
+//
+//	table.BigInteger("number").Default(sqlserver.NextValueFor("order_numbers"))
+func NextValueFor(sequence string) SequenceDefault {
+	return SequenceDefault(sequence)
+}
+
+// CreateSequence Create the sequence.
+func (r *Sqlserver) CreateSequence(orm contractsorm.Orm, sequence Sequence) error {
+	_, err := orm.Query().Exec(r.grammar().CompileCreateSequence(sequence))
+
+	return err
+}
+
+// AlterSequence Alter the sequence, the type can't be altered.
+func (r *Sqlserver) AlterSequence(orm contractsorm.Orm, sequence Sequence) error {
+	_, err := orm.Query().Exec(r.grammar().CompileAlterSequence(sequence))
+
+	return err
+}
+
+// DropSequence Drop the sequence if it exists.
+func (r *Sqlserver) DropSequence(orm contractsorm.Orm, name string) error {
+	_, err := orm.Query().Exec(r.grammar().CompileDropSequence(name))
+
+	return err
+}
+
+// GetSequences Get the sequences of the default schema of the connection, or of all schemas when it isn't set.
+func (r *Sqlserver) GetSequences(orm contractsorm.Orm) ([]Sequence, error) {
+	var sequences []Sequence
+	if err := orm.Query().Raw(r.grammar().CompileSequences()).Scan(&sequences); err != nil {
+		return nil, err
+	}
+
+	return sequences, nil
+}
+
+// NextValue Get the next value of the sequence.
+func (r *Sqlserver) NextValue(orm contractsorm.Orm, sequence string) (int64, error) {
+	var value int64
+	if err := orm.Query().Raw(r.grammar().CompileNextValue(sequence)).Scan(&value); err != nil {
+		return 0, err
+	}
+
+	return value, nil
+}
+
+// NextValues Reserve the next size values of the sequence at once, and get the first one. The values step by the
+// increment of the sequence.
+func (r *Sqlserver) NextValues(orm contractsorm.Orm, sequence string, size int64) (int64, error) {
+	var value int64
+	if err := orm.Query().Raw(r.grammar().CompileSequenceRange(sequence, size)).Scan(&value); err != nil {
+		return 0, err
+	}
+
+	return value, nil
+}
@@ -0,0 +1,48 @@
+package sqlserver
+
+import (
+	"testing"
+
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
+	mocksdriver "github.com/goravel/framework/mocks/database/driver"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/sqlserver/contracts"
+)
+
+func TestNextValueFor(t *testing.T) {
+	mockColumn := mocksdriver.NewColumnDefinition(t)
+	mockColumn.EXPECT().IsChange().Return(false).Twice()
+	mockColumn.EXPECT().GetDefault().Return(NextValueFor("order_numbers")).Times(2)
+	assert.Equal(t, ` default next value for "app"."order_numbers"`, NewGrammarWithConfig(contracts.FullConfig{Schema: "app"}).ModifyDefault(nil, mockColumn))
+
+	mockColumn.EXPECT().GetDefault().Return(NextValueFor(`sales.order"numbers`)).Times(2)
+	assert.Equal(t, ` default next value for "sales"."order""numbers"`, NewGrammarWithConfig(contracts.FullConfig{Schema: "app"}).ModifyDefault(nil, mockColumn))
+}
+
+func TestSequences(t *testing.T) {
+	assertDriverError(t, func(sqlserver *Sqlserver, orm contractsorm.Orm) error {
+		return sqlserver.CreateSequence(orm, Sequence{Name: "order_numbers"})
+	})
+	assertDriverError(t, func(sqlserver *Sqlserver, orm contractsorm.Orm) error {
+		return sqlserver.AlterSequence(orm, Sequence{Name: "order_numbers", Cache: 20})
+	})
+	assertDriverError(t, func(sqlserver *Sqlserver, orm contractsorm.Orm) error {
+		return sqlserver.DropSequence(orm, "order_numbers")
+	})
+	assertDriverError(t, func(sqlserver *Sqlserver, orm contractsorm.Orm) error {
+		_, err := sqlserver.GetSequences(orm)
+
+		return err
+	})
+	assertDriverError(t, func(sqlserver *Sqlserver, orm contractsorm.Orm) error {
+		_, err := sqlserver.NextValue(orm, "order_numbers")
+
+		return err
+	})
+	assertDriverError(t, func(sqlserver *Sqlserver, orm contractsorm.Orm) error {
+		_, err := sqlserver.NextValues(orm, "order_numbers", 100)
+
+		return err
+	})
+}
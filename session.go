@@ -0,0 +1,72 @@
+package sqlserver
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/goravel/sqlserver/contracts"
+)
+
+var (
+	isolationLevels    = []string{"READ UNCOMMITTED", "READ COMMITTED", "REPEATABLE READ", "SNAPSHOT", "SERIALIZABLE"}
+	deadlockPriorities = []string{"LOW", "NORMAL", "HIGH"}
+)
+
+// sessionInitSQL Builds the statements that the connector runs on every new or reused connection.
+func sessionInitSQL(session contracts.Session) (string, error) {
+	var statements []string
+	if session.XactAbort {
+		statements = append(statements, "SET XACT_ABORT ON")
+	}
+	if session.ArithAbort {
+		statements = append(statements, "SET ARITHABORT ON")
+	}
+	if session.LockTimeout != 0 {
+		statements = append(statements, fmt.Sprintf("SET LOCK_TIMEOUT %d", session.LockTimeout))
+	}
+	if session.DateFirst != 0 {
+		if session.DateFirst < 1 || session.DateFirst > 7 {
+			return "", InvalidSessionOption.Args("date first", session.DateFirst)
+		}
+		statements = append(statements, fmt.Sprintf("SET DATEFIRST %d", session.DateFirst))
+	}
+	if session.Language != "" {
+		statements = append(statements, fmt.Sprintf("SET LANGUAGE N'%s'", strings.ReplaceAll(session.Language, "'", "''")))
+	}
+	if session.DeadlockPriority != "" {
+		priority := strings.ToUpper(session.DeadlockPriority)
+		if number, err := strconv.Atoi(priority); err == nil {
+			if number < -10 || number > 10 {
+				return "", InvalidSessionOption.Args("deadlock priority", session.DeadlockPriority)
+			}
+		} else if !slices.Contains(deadlockPriorities, priority) {
+			return "", InvalidSessionOption.Args("deadlock priority", session.DeadlockPriority)
+		}
+		statements = append(statements, "SET DEADLOCK_PRIORITY "+priority)
+	}
+	if session.IsolationLevel != "" {
+		level := strings.ToUpper(session.IsolationLevel)
+		if !slices.Contains(isolationLevels, level) {
+			return "", InvalidSessionOption.Args("isolation level", session.IsolationLevel)
+		}
+		statements = append(statements, "SET TRANSACTION ISOLATION LEVEL "+level)
+	}
+	for _, statement := range session.Statements {
+		if statement = strings.TrimSuffix(strings.TrimSpace(statement), ";"); statement != "" {
+			statements = append(statements, statement)
+		}
+	}
+
+	if len(statements) == 0 {
+		return "", nil
+	}
+
+	return strings.Join(statements, "; ") + ";", nil
+}
+
+func isZeroSession(session contracts.Session) bool {
+	return !session.XactAbort && !session.ArithAbort && session.LockTimeout == 0 && session.DateFirst == 0 &&
+		session.Language == "" && session.DeadlockPriority == "" && session.IsolationLevel == "" && len(session.Statements) == 0
+}
@@ -0,0 +1,73 @@
+package sqlserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/sqlserver/contracts"
+)
+
+func TestSessionInitSQL(t *testing.T) {
+	tests := []struct {
+		name      string
+		session   contracts.Session
+		expectSQL string
+		expectErr error
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "all options",
+			session: contracts.Session{
+				XactAbort:        true,
+				ArithAbort:       true,
+				LockTimeout:      5000,
+				DateFirst:        1,
+				Language:         "us_english",
+				DeadlockPriority: "low",
+				IsolationLevel:   "read committed",
+				Statements:       []string{"SET NOCOUNT ON;", " "},
+			},
+			expectSQL: "SET XACT_ABORT ON; SET ARITHABORT ON; SET LOCK_TIMEOUT 5000; SET DATEFIRST 1; SET LANGUAGE N'us_english'; SET DEADLOCK_PRIORITY LOW; SET TRANSACTION ISOLATION LEVEL READ COMMITTED; SET NOCOUNT ON;",
+		},
+		{
+			name:      "wait forever and numeric deadlock priority",
+			session:   contracts.Session{LockTimeout: -1, DeadlockPriority: "-5"},
+			expectSQL: "SET LOCK_TIMEOUT -1; SET DEADLOCK_PRIORITY -5;",
+		},
+		{
+			name:      "escape language",
+			session:   contracts.Session{Language: "us'english"},
+			expectSQL: "SET LANGUAGE N'us''english';",
+		},
+		{
+			name:      "invalid date first",
+			session:   contracts.Session{DateFirst: 8},
+			expectErr: InvalidSessionOption.Args("date first", 8),
+		},
+		{
+			name:      "invalid deadlock priority",
+			session:   contracts.Session{DeadlockPriority: "11"},
+			expectErr: InvalidSessionOption.Args("deadlock priority", "11"),
+		},
+		{
+			name:      "invalid isolation level",
+			session:   contracts.Session{IsolationLevel: "chaos"},
+			expectErr: InvalidSessionOption.Args("isolation level", "chaos"),
+		},
+	}
+
+	assert.True(t, isZeroSession(contracts.Session{}))
+	assert.False(t, isZeroSession(contracts.Session{Statements: []string{"SET NOCOUNT ON"}}))
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sql, err := sessionInitSQL(test.session)
+
+			assert.Equal(t, test.expectSQL, sql)
+			assert.Equal(t, test.expectErr, err)
+		})
+	}
+}
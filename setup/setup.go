@@ -19,7 +19,6 @@ func main() {
         "database": config.Env("DB_DATABASE"),
         "username": config.Env("DB_USERNAME"),
         "password": config.Env("DB_PASSWORD"),
-        "charset":  "utf8mb4",
         "prefix":   "",
         "singular": false,
         "via": func() (driver.Driver, error) {
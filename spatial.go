@@ -0,0 +1,86 @@
+package sqlserver
+
+import (
+	"github.com/goravel/framework/contracts/database/driver"
+	contractsschema "github.com/goravel/framework/contracts/database/schema"
+)
+
+// SpatialIndex The options of the spatial index, the zero values keep the defaults of SQL Server.
+type SpatialIndex struct {
+	// Tessellation GEOMETRY_GRID, GEOMETRY_AUTO_GRID, GEOGRAPHY_GRID or GEOGRAPHY_AUTO_GRID, SQL Server uses the auto
+	// grid of the column type when empty.
+	Tessellation string
+	// BoundingBox The xmin, ymin, xmax and ymax of the grid, the geometry grids require it.
+	BoundingBox []float64
+	// Grids The density of the levels 1 to 4 of the grid, LOW, MEDIUM or HIGH, the empty levels are MEDIUM.
+	Grids []string
+	// CellsPerObject The max number of cells an object is tessellated into.
+	CellsPerObject int
+}
+
+// Shape The geometry or geography instance that the spatial predicates compare the column with, its values are bound
+// as parameters.
+type Shape struct {
+	sql  string
+	args []any
+}
+
+// Geometry Add the geometry column to the blueprint, the column only accepts the values of the SRID when it's given.
+//
+//	sqlserver.Geometry(table, "area", 0)
+func Geometry(blueprint contractsschema.Blueprint, column string, srid ...int) driver.ColumnDefinition {
+	return spatialColumn(blueprint.Column(column, "geometry"), srid)
+}
+
+// Geography Add the geography column to the blueprint, the column only accepts the values of the SRID when it's given.
+//
+//	sqlserver.Geography(table, "location", 4326)
+func Geography(blueprint contractsschema.Blueprint, column string, srid ...int) driver.ColumnDefinition {
+	return spatialColumn(blueprint.Column(column, "geography"), srid)
+}
+
+// TableSpatialIndex Create the spatial index of the geometry or geography column, the table needs a clustered primary
+// key. The index is dropped by DropIndex.
+//
+//	sqlserver.TableSpatialIndex(table, "location", sqlserver.SpatialIndex{CellsPerObject: 16})
+func TableSpatialIndex(blueprint contractsschema.Blueprint, column string, options SpatialIndex) contractsschema.IndexDefinition {
+	definition := blueprint.Index(column)
+	commands := blueprint.GetCommands()
+	setCommandOptions(commands[len(commands)-1], func(extra *extraOptions) {
+		extra.spatial = &options
+	})
+
+	return definition
+}
+
+// GeometryFromText The geometry instance of the well-known text, such as POLYGON((0 0, 10 0, 10 10, 0 0)).
+func GeometryFromText(wkt string, srid int) Shape {
+	return Shape{sql: "geometry::STGeomFromText(?, ?)", args: []any{wkt, srid}}
+}
+
+// GeometryPoint The geometry point at the coordinates.
+func GeometryPoint(x, y float64, srid int) Shape {
+	return Shape{sql: "geometry::Point(?, ?, ?)", args: []any{x, y, srid}}
+}
+
+// GeographyFromText The geography instance of the well-known text, the points of it are in longitude latitude order.
+func GeographyFromText(wkt string, srid int) Shape {
+	return Shape{sql: "geography::STGeomFromText(?, ?)", args: []any{wkt, srid}}
+}
+
+// GeographyPoint The geography point at the latitude and longitude.
+//
+//	sqlserver.GeographyPoint(47.6062, -122.3321, 4326)
+func GeographyPoint(latitude, longitude float64, srid int) Shape {
+	return Shape{sql: "geography::Point(?, ?, ?)", args: []any{latitude, longitude, srid}}
+}
+
+func spatialColumn(column driver.ColumnDefinition, srids []int) driver.ColumnDefinition {
+	if len(srids) > 0 {
+		setColumnOptions(column, func(extra *extraOptions) {
+			extra.srid = &srids[0]
+		})
+	}
+
+	return column
+}
@@ -1,7 +1,13 @@
 package sqlserver
 
 import (
+	"crypto/sha256"
+	"database/sql"
+	sqldriver "database/sql/driver"
+	"encoding/hex"
 	"fmt"
+	"io"
+	"sync"
 
 	"github.com/goravel/framework/contracts/config"
 	"github.com/goravel/framework/contracts/database"
@@ -10,6 +16,8 @@ import (
 	"github.com/goravel/framework/contracts/process"
 	"github.com/goravel/framework/contracts/testing/docker"
 	"github.com/goravel/framework/errors"
+	mssql "github.com/microsoft/go-mssqldb"
+	"github.com/microsoft/go-mssqldb/azuread"
 	"gorm.io/driver/sqlserver"
 	"gorm.io/gorm"
 
@@ -18,6 +26,14 @@ import (
 
 var _ driver.Driver = &Sqlserver{}
 
+var (
+	// pools The pools opened by the connectors, keyed by the hash of the DSN and the settings of the connector. A new
+	// Sqlserver is built every time the driver is resolved, so the pools are kept here to open one pool per connection
+	// config, and a pool is removed when it's closed.
+	pools     = make(map[string]*sql.DB)
+	poolsLock sync.Mutex
+)
+
 type Sqlserver struct {
 	config  contracts.ConfigBuilder
 	log     log.Log
@@ -42,11 +58,18 @@ func (r *Sqlserver) Docker() (docker.DatabaseDriver, error) {
 		return nil, errors.DatabaseConfigNotFound
 	}
 
-	return NewDocker(r.config, r.process, writers[0].Database, writers[0].Username, writers[0].Password), nil
+	docker := NewDocker(r.config, r.process, writers[0].Database, writers[0].Username, writers[0].Password)
+	if encrypt := writers[0].TLS.Encrypt; encrypt == contracts.EncryptTrue || encrypt == contracts.EncryptStrict {
+		if err := docker.TLS(encrypt); err != nil {
+			return nil, err
+		}
+	}
+
+	return docker, nil
 }
 
 func (r *Sqlserver) Grammar() driver.Grammar {
-	return NewGrammar(r.config.Writers()[0].Prefix)
+	return NewGrammarWithConfig(r.config.Writers()[0])
 }
 
 func (r *Sqlserver) Pool() database.Pool {
@@ -63,12 +86,17 @@ func (r *Sqlserver) Processor() driver.Processor {
 func (r *Sqlserver) fullConfigsToConfigs(fullConfigs []contracts.FullConfig) []database.Config {
 	configs := make([]database.Config, len(fullConfigs))
 	for i, fullConfig := range fullConfigs {
+		dialector, err := fullConfigToDialector(fullConfig)
+		if err != nil {
+			dialector = failedDialector{err: err}
+		}
+
 		configs[i] = database.Config{
 			Charset:      fullConfig.Charset,
 			Connection:   fullConfig.Connection,
 			Dsn:          fullConfig.Dsn,
 			Database:     fullConfig.Database,
-			Dialector:    fullConfigToDialector(fullConfig),
+			Dialector:    dialector,
 			Driver:       Name,
 			Host:         fullConfig.Host,
 			NameReplacer: fullConfig.NameReplacer,
@@ -76,6 +104,7 @@ func (r *Sqlserver) fullConfigsToConfigs(fullConfigs []contracts.FullConfig) []d
 			Password:     fullConfig.Password,
 			Port:         fullConfig.Port,
 			Prefix:       fullConfig.Prefix,
+			Schema:       fullConfig.Schema,
 			Singular:     fullConfig.Singular,
 			Username:     fullConfig.Username,
 			Timezone:     fullConfig.Timezone,
@@ -85,25 +114,103 @@ func (r *Sqlserver) fullConfigsToConfigs(fullConfigs []contracts.FullConfig) []d
 	return configs
 }
 
-func dsn(fullConfig contracts.FullConfig) string {
-	if fullConfig.Dsn != "" {
-		return fullConfig.Dsn
+func fullConfigToDialector(fullConfig contracts.FullConfig) (gorm.Dialector, error) {
+	dsn := dsn(fullConfig)
+	if dsn == "" {
+		return nil, nil
 	}
-	if fullConfig.Host == "" {
-		return ""
+
+	if fullConfig.Auth.Method == "" && fullConfig.Retry.MaxAttempts < 2 && isZeroSession(fullConfig.Session) {
+		return sqlserver.New(sqlserver.Config{
+			DSN: dsn,
+		}), nil
 	}
 
-	return fmt.Sprintf("sqlserver://%s:%s@%s:%d?database=%s&charset=%s&timezone=%s&MultipleActiveResultSets=true",
-		fullConfig.Username, fullConfig.Password, fullConfig.Host, fullConfig.Port, fullConfig.Database, fullConfig.Charset, fullConfig.Timezone)
+	db, err := openPool(fullConfig, dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	return sqlserver.New(sqlserver.Config{
+		Conn: db,
+	}), nil
 }
 
-func fullConfigToDialector(fullConfig contracts.FullConfig) gorm.Dialector {
-	dsn := dsn(fullConfig)
-	if dsn == "" {
-		return nil
+func openPool(fullConfig contracts.FullConfig, dsn string) (*sql.DB, error) {
+	// The DSN has the password, so only its hash is kept.
+	hash := sha256.Sum256(fmt.Appendf(nil, "%s\n%s\n%+v\n%+v", fullConfig.Auth.Method, dsn, fullConfig.Session, fullConfig.Retry))
+	key := hex.EncodeToString(hash[:])
+
+	poolsLock.Lock()
+	defer poolsLock.Unlock()
+
+	if db, ok := pools[key]; ok {
+		return db, nil
 	}
 
-	return sqlserver.New(sqlserver.Config{
-		DSN: dsn,
-	})
+	connector, err := newConnector(fullConfig, dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	db := sql.OpenDB(&pooledConnector{Connector: connector, key: key})
+	pools[key] = db
+
+	return db, nil
+}
+
+func newConnector(fullConfig contracts.FullConfig, dsn string) (sqldriver.Connector, error) {
+	var (
+		connector *mssql.Connector
+		err       error
+	)
+	if fullConfig.Auth.Method == "" {
+		connector, err = mssql.NewConnector(dsn)
+	} else {
+		// Entra ID authentication needs a token provider, which only the azuread connector can supply.
+		connector, err = azuread.NewConnector(dsn)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The connector runs it on every new connection and every time a connection is reused from the pool.
+	if connector.SessionInitSQL, err = sessionInitSQL(fullConfig.Session); err != nil {
+		return nil, err
+	}
+
+	if fullConfig.Retry.MaxAttempts > 1 {
+		return newRetryConnector(connector, fullConfig.Retry), nil
+	}
+
+	return connector, nil
+}
+
+// failedDialector The dialector of the connection that can't be opened, gorm.Open returns its error when the
+// connection is used.
+type failedDialector struct {
+	sqlserver.Dialector
+	err error
+}
+
+func (r failedDialector) Initialize(*gorm.DB) error {
+	return r.err
+}
+
+// pooledConnector Remove the pool from the pools when it's closed, database/sql closes the connector with the pool.
+type pooledConnector struct {
+	sqldriver.Connector
+	key string
+}
+
+func (r *pooledConnector) Close() error {
+	poolsLock.Lock()
+	delete(pools, r.key)
+	poolsLock.Unlock()
+
+	if closer, ok := r.Connector.(io.Closer); ok {
+		return closer.Close()
+	}
+
+	return nil
 }
@@ -0,0 +1,168 @@
+package sqlserver
+
+import (
+	"database/sql"
+	"testing"
+
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
+	mocksorm "github.com/goravel/framework/mocks/database/orm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/driver/sqlserver"
+	"gorm.io/gorm"
+
+	"github.com/goravel/sqlserver/contracts"
+	mocks "github.com/goravel/sqlserver/mocks"
+)
+
+func TestFullConfigToDialector(t *testing.T) {
+	config := contracts.FullConfig{
+		Config: contracts.Config{
+			Host:     "localhost",
+			Port:     1433,
+			Database: "forge",
+			Username: "root",
+			Password: "123123",
+		},
+	}
+
+	t.Run("return nil when host is empty", func(t *testing.T) {
+		dialector, err := fullConfigToDialector(contracts.FullConfig{})
+
+		assert.NoError(t, err)
+		assert.Nil(t, dialector)
+	})
+
+	t.Run("use dsn for sql server authentication", func(t *testing.T) {
+		result, err := fullConfigToDialector(config)
+		assert.NoError(t, err)
+		dialector, ok := result.(*sqlserver.Dialector)
+
+		assert.True(t, ok)
+		assert.NotEmpty(t, dialector.DSN)
+		assert.Nil(t, dialector.Conn)
+	})
+
+	t.Run("use azuread connector for entra id authentication", func(t *testing.T) {
+		for _, auth := range []contracts.Auth{
+			{Method: contracts.AuthActiveDirectoryPassword, ClientID: "app"},
+			{Method: contracts.AuthActiveDirectoryServicePrincipal, ClientID: "client", TenantID: "tenant", ClientSecret: "secret"},
+			{Method: contracts.AuthActiveDirectoryServicePrincipal, ClientID: "client", CertificatePath: "client.pem"},
+			{Method: contracts.AuthActiveDirectoryManagedIdentity},
+			{Method: contracts.AuthActiveDirectoryDefault},
+		} {
+			fullConfig := config
+			fullConfig.Auth = auth
+			result, err := fullConfigToDialector(fullConfig)
+			assert.NoError(t, err, auth.Method)
+			dialector, ok := result.(*sqlserver.Dialector)
+
+			assert.True(t, ok, auth.Method)
+			assert.Empty(t, dialector.DSN, auth.Method)
+			assert.NotNil(t, dialector.Conn, auth.Method)
+		}
+	})
+
+	t.Run("use retry connector when retry is enabled", func(t *testing.T) {
+		fullConfig := config
+		fullConfig.Retry = contracts.Retry{MaxAttempts: 3}
+		result, err := fullConfigToDialector(fullConfig)
+		assert.NoError(t, err)
+		dialector, ok := result.(*sqlserver.Dialector)
+
+		assert.True(t, ok)
+		assert.Empty(t, dialector.DSN)
+		assert.NotNil(t, dialector.Conn)
+	})
+
+	t.Run("use connector when session is set", func(t *testing.T) {
+		fullConfig := config
+		fullConfig.Session = contracts.Session{XactAbort: true}
+		result, err := fullConfigToDialector(fullConfig)
+		assert.NoError(t, err)
+		dialector, ok := result.(*sqlserver.Dialector)
+
+		assert.True(t, ok)
+		assert.Empty(t, dialector.DSN)
+		assert.NotNil(t, dialector.Conn)
+
+		fullConfig.Session = contracts.Session{IsolationLevel: "chaos"}
+		result, err = fullConfigToDialector(fullConfig)
+		assert.ErrorIs(t, err, InvalidSessionOption)
+		assert.Nil(t, result)
+	})
+
+	t.Run("return error when the auth is invalid", func(t *testing.T) {
+		fullConfig := config
+		fullConfig.Auth = contracts.Auth{Method: contracts.AuthActiveDirectoryPassword}
+		dialector, err := fullConfigToDialector(fullConfig)
+
+		assert.Error(t, err)
+		assert.Nil(t, dialector)
+	})
+
+	t.Run("open one pool per connection config", func(t *testing.T) {
+		fullConfig := config
+		fullConfig.Connection = "pool"
+		fullConfig.Retry = contracts.Retry{MaxAttempts: 3}
+		first, err := fullConfigToDialector(fullConfig)
+		assert.NoError(t, err)
+		second, err := fullConfigToDialector(fullConfig)
+		assert.NoError(t, err)
+
+		db := first.(*sqlserver.Dialector).Conn
+		assert.Same(t, db, second.(*sqlserver.Dialector).Conn)
+
+		assert.NoError(t, db.(*sql.DB).Close())
+		third, err := fullConfigToDialector(fullConfig)
+		assert.NoError(t, err)
+		assert.NotSame(t, db, third.(*sqlserver.Dialector).Conn)
+
+		fullConfig.Timezone = "UTC"
+		fourth, err := fullConfigToDialector(fullConfig)
+		assert.NoError(t, err)
+		assert.NotSame(t, third.(*sqlserver.Dialector).Conn, fourth.(*sqlserver.Dialector).Conn)
+
+		poolsLock.Lock()
+		for key := range pools {
+			assert.NotContains(t, key, fullConfig.Password)
+		}
+		poolsLock.Unlock()
+	})
+}
+
+func TestPool(t *testing.T) {
+	mockConfig := mocks.NewConfigBuilder(t)
+	mockConfig.EXPECT().Readers().Return(nil).Once()
+	mockConfig.EXPECT().Writers().Return([]contracts.FullConfig{{
+		Config: contracts.Config{
+			Host:    "localhost",
+			Session: contracts.Session{IsolationLevel: "chaos"},
+		},
+	}}).Once()
+
+	pool := (&Sqlserver{config: mockConfig}).Pool()
+
+	_, err := gorm.Open(pool.Writers[0].Dialector)
+	assert.ErrorIs(t, err, InvalidSessionOption)
+}
+
+// assertDriverError Assert the driver method returns the error of the statement it runs. The statements themselves are
+// asserted by GrammarSuite, so the query accepts any of them.
+func assertDriverError(t *testing.T, run func(sqlserver *Sqlserver, orm contractsorm.Orm) error) {
+	t.Helper()
+
+	mockConfig := mocks.NewConfigBuilder(t)
+	mockOrm := mocksorm.NewOrm(t)
+	mockQuery := mocksorm.NewQuery(t)
+
+	mockConfig.EXPECT().Writers().Return([]contracts.FullConfig{{}}).Once()
+	mockOrm.EXPECT().Name().Return("sqlserver").Maybe()
+	mockOrm.EXPECT().Connection("sqlserver").Return(mockOrm).Maybe()
+	mockOrm.EXPECT().Query().Return(mockQuery).Once()
+	mockQuery.EXPECT().Exec(mock.Anything).Return(nil, assert.AnError).Maybe()
+	mockQuery.EXPECT().Raw(mock.Anything).Return(mockQuery).Maybe()
+	mockQuery.EXPECT().Scan(mock.Anything).Return(assert.AnError).Maybe()
+
+	assert.Equal(t, assert.AnError, run(&Sqlserver{config: mockConfig}, mockOrm))
+}
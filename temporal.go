@@ -0,0 +1,147 @@
+package sqlserver
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/goravel/framework/contracts/database/driver"
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
+	"github.com/goravel/framework/database/schema"
+	"gorm.io/driver/sqlserver"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	defaultValidFrom = "valid_from"
+	defaultValidTo   = "valid_to"
+)
+
+// SystemVersioning The options of the system-versioned temporal table.
+type SystemVersioning struct {
+	// HistoryTable The history table, it's <table>_history in the schema of the table when empty. The prefix of the
+	// tables is added to it, and it's in the dbo schema when neither it nor the table is qualified by the schema.
+	HistoryTable string
+	// RetentionPeriod How long the rows are kept in the history table, such as 6 MONTHS, forever when empty.
+	RetentionPeriod string
+	// ValidFrom The period start column, it's valid_from when empty.
+	ValidFrom string
+	// ValidTo The period end column, it's valid_to when empty.
+	ValidTo string
+	// Hidden Hide the period columns from select * and the inserts without a column list.
+	Hidden bool
+}
+
+// SystemVersioned Make the table created by the blueprint a system-versioned temporal table, the period columns are
+// added to it. The table needs a primary key.
+//
+//	facades.Schema().Create("users", func(table schema.Blueprint) {
+//		table.ID()
+//		sqlserver.SystemVersioned(table, sqlserver.SystemVersioning{RetentionPeriod: "6 MONTHS"})
+//	})
+func SystemVersioned(blueprint driver.Blueprint, options SystemVersioning) {
+	for _, command := range blueprint.GetCommands() {
+		if command.Name == schema.CommandCreate {
+			setCommandOptions(command, func(extra *extraOptions) {
+				extra.systemVersioning = &options
+			})
+
+			return
+		}
+	}
+}
+
+// EnableSystemVersioning Make the existing table a system-versioned temporal table, the period columns are added to it
+// if they don't exist.
+func (r *Sqlserver) EnableSystemVersioning(orm contractsorm.Orm, table string, options SystemVersioning) error {
+	for _, sql := range r.grammar().CompileEnableSystemVersioning(table, options) {
+		if _, err := orm.Query().Exec(sql); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DisableSystemVersioning Turn the system versioning of the table off, the period columns and the history table are
+// kept.
+func (r *Sqlserver) DisableSystemVersioning(orm contractsorm.Orm, table string) error {
+	_, err := orm.Query().Exec(r.grammar().CompileDisableSystemVersioning(table))
+
+	return err
+}
+
+// ForSystemTime The FOR SYSTEM_TIME clause of the temporal table, it's added after the FROM clause of the gorm
+// statements, the same as WithHint. Its String is added to the table of the query builder:
+//
+//	facades.DB().Table("users " + sqlserver.SystemTimeAsOf(at).String())
+type ForSystemTime struct {
+	Type  string
+	Times []time.Time
+}
+
+// SystemTimeAsOf The rows that were current at the time.
+func SystemTimeAsOf(at time.Time) ForSystemTime {
+	return ForSystemTime{Type: "AS OF", Times: []time.Time{at}}
+}
+
+// SystemTimeFromTo The rows that were current in the period, excluding the ones that became current at the end.
+func SystemTimeFromTo(start, end time.Time) ForSystemTime {
+	return ForSystemTime{Type: "FROM", Times: []time.Time{start, end}}
+}
+
+// SystemTimeBetween The rows that were current in the period, including the ones that became current at the end.
+func SystemTimeBetween(start, end time.Time) ForSystemTime {
+	return ForSystemTime{Type: "BETWEEN", Times: []time.Time{start, end}}
+}
+
+// SystemTimeContainedIn The rows that became current and stopped being current in the period.
+func SystemTimeContainedIn(start, end time.Time) ForSystemTime {
+	return ForSystemTime{Type: "CONTAINED IN", Times: []time.Time{start, end}}
+}
+
+// SystemTimeAll All the rows of the current and history tables.
+func SystemTimeAll() ForSystemTime {
+	return ForSystemTime{Type: "ALL"}
+}
+
+func (systemTime ForSystemTime) ModifyStatement(stmt *gorm.Statement) {
+	dialector := sqlserver.Dialector{}
+	if stmt.Name() == dialector.Name() {
+		clause := stmt.Clauses["FROM"]
+
+		if clause.AfterExpression == nil {
+			clause.AfterExpression = systemTime
+		} else {
+			clause.AfterExpression = Exprs{clause.AfterExpression, systemTime}
+		}
+
+		stmt.Clauses["FROM"] = clause
+	}
+}
+
+func (systemTime ForSystemTime) Build(builder clause.Builder) {
+	_, _ = builder.WriteString(systemTime.String())
+}
+
+// String The clause with the times as UTC literals, the period columns are in UTC.
+func (systemTime ForSystemTime) String() string {
+	times := make([]string, len(systemTime.Times))
+	for i, t := range systemTime.Times {
+		times[i] = fmt.Sprintf("'%s'", t.UTC().Format("2006-01-02T15:04:05.0000000"))
+	}
+
+	switch systemTime.Type {
+	case "AS OF":
+		return "FOR SYSTEM_TIME AS OF " + times[0]
+	case "FROM":
+		return fmt.Sprintf("FOR SYSTEM_TIME FROM %s TO %s", times[0], times[1])
+	case "BETWEEN":
+		return fmt.Sprintf("FOR SYSTEM_TIME BETWEEN %s AND %s", times[0], times[1])
+	case "CONTAINED IN":
+		return fmt.Sprintf("FOR SYSTEM_TIME CONTAINED IN (%s)", strings.Join(times, ", "))
+	default:
+		return "FOR SYSTEM_TIME " + systemTime.Type
+	}
+}
@@ -0,0 +1,48 @@
+package sqlserver
+
+import (
+	"context"
+	"time"
+
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
+
+	"github.com/goravel/sqlserver/contracts"
+)
+
+const (
+	defaultDeadlockAttempts       = 3
+	defaultDeadlockInitialBackoff = 100 * time.Millisecond
+	defaultDeadlockMaxBackoff     = 2 * time.Second
+)
+
+// deadlockErrors The errors of a transaction that can succeed when it runs again: 1205 is the deadlock victim,
+// 3960 is the snapshot update conflict.
+var deadlockErrors = []int32{1205, 3960}
+
+// RetryOnDeadlock Runs fn in a transaction, and runs it again in a new transaction when it's chosen as the deadlock
+// victim or hits a snapshot update conflict. MaxAttempts is 3, InitialBackoff is 100ms, MaxBackoff is 2s and Errors
+// are 1205 and 3960 when they are empty in opts.
+func (r *Sqlserver) RetryOnDeadlock(ctx context.Context, orm contractsorm.Orm, fn func(tx contractsorm.Query) error, opts contracts.Retry) error {
+	if opts.MaxAttempts == 0 {
+		opts.MaxAttempts = defaultDeadlockAttempts
+	}
+	if opts.InitialBackoff == 0 {
+		opts.InitialBackoff = defaultDeadlockInitialBackoff
+	}
+	if opts.MaxBackoff == 0 {
+		opts.MaxBackoff = defaultDeadlockMaxBackoff
+	}
+	if len(opts.Errors) == 0 {
+		opts.Errors = deadlockErrors
+	}
+
+	orm = orm.WithContext(ctx)
+
+	return doWithRetry(ctx, opts, func() error {
+		return orm.Transaction(fn)
+	}, func(attempt int, delay time.Duration, err error) {
+		if r.log != nil {
+			r.log.Warningf("[%s] transaction attempt %d of %d failed, retrying in %s: %v", Name, attempt, opts.MaxAttempts, delay, err)
+		}
+	})
+}
@@ -0,0 +1,88 @@
+package sqlserver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
+	mocksorm "github.com/goravel/framework/mocks/database/orm"
+	mockslog "github.com/goravel/framework/mocks/log"
+	mssql "github.com/microsoft/go-mssqldb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goravel/sqlserver/contracts"
+)
+
+func TestRetryOnDeadlock(t *testing.T) {
+	ctx := context.Background()
+	opts := contracts.Retry{
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Millisecond,
+	}
+	fn := func(tx contractsorm.Query) error {
+		return nil
+	}
+
+	tests := []struct {
+		name        string
+		errors      []error
+		opts        contracts.Retry
+		expectCalls int
+		expectErr   error
+	}{
+		{
+			name:        "success",
+			errors:      []error{nil},
+			opts:        opts,
+			expectCalls: 1,
+		},
+		{
+			name:        "retry deadlock and snapshot conflict",
+			errors:      []error{mssql.Error{Number: 1205}, mssql.Error{Number: 3960}, nil},
+			opts:        opts,
+			expectCalls: 3,
+		},
+		{
+			name:        "stop after max attempts",
+			errors:      []error{mssql.Error{Number: 1205}, mssql.Error{Number: 1205}},
+			opts:        contracts.Retry{MaxAttempts: 2, InitialBackoff: time.Millisecond},
+			expectCalls: 2,
+			expectErr:   mssql.Error{Number: 1205},
+		},
+		{
+			name:        "don't retry other errors",
+			errors:      []error{mssql.Error{Number: 2627}},
+			opts:        opts,
+			expectCalls: 1,
+			expectErr:   mssql.Error{Number: 2627},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockOrm := mocksorm.NewOrm(t)
+			mockLog := mockslog.NewLog(t)
+			mockOrm.EXPECT().WithContext(ctx).Return(mockOrm).Once()
+			for _, err := range test.errors {
+				mockOrm.EXPECT().Transaction(mock.Anything).Return(err).Once()
+			}
+			if test.expectCalls > 1 {
+				mockLog.EXPECT().Warningf(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Times(test.expectCalls - 1)
+			}
+
+			sqlserver := &Sqlserver{log: mockLog}
+			err := sqlserver.RetryOnDeadlock(ctx, mockOrm, fn, test.opts)
+
+			if test.expectErr != nil {
+				var sqlErr mssql.Error
+				assert.True(t, errors.As(err, &sqlErr))
+				assert.Equal(t, test.expectErr, sqlErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
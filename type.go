@@ -0,0 +1,38 @@
+package sqlserver
+
+import (
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
+	contractsschema "github.com/goravel/framework/contracts/database/schema"
+	"github.com/goravel/framework/database/schema"
+)
+
+// CreateType Create the alias type based on the system type, the name can be qualified by the schema.
+//
+//	driver.CreateType(facades.Orm(), "Email", "nvarchar(320) not null")
+func (r *Sqlserver) CreateType(orm contractsorm.Orm, name, definition string) error {
+	_, err := orm.Query().Exec(r.grammar().CompileCreateType(name, definition))
+
+	return err
+}
+
+// CreateTableType Create the user-defined table type with the columns, primary key, unique and index defined in
+// the callback, the name can be qualified by the schema.
+func (r *Sqlserver) CreateTableType(orm contractsorm.Orm, name string, callback func(table contractsschema.Blueprint)) error {
+	blueprint := schema.NewBlueprint(nil, "", name)
+	callback(blueprint)
+
+	_, err := orm.Query().Exec(r.grammar().CompileCreateTableType(blueprint))
+
+	return err
+}
+
+// DropType Drop the alias type or the table type if it exists.
+func (r *Sqlserver) DropType(orm contractsorm.Orm, name string) error {
+	_, err := orm.Query().Exec(r.grammar().CompileDropType(name))
+
+	return err
+}
+
+func (r *Sqlserver) grammar() *Grammar {
+	return NewGrammarWithConfig(r.config.Writers()[0])
+}
@@ -0,0 +1,22 @@
+package sqlserver
+
+import (
+	"testing"
+
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
+	contractsschema "github.com/goravel/framework/contracts/database/schema"
+)
+
+func TestTypes(t *testing.T) {
+	assertDriverError(t, func(sqlserver *Sqlserver, orm contractsorm.Orm) error {
+		return sqlserver.CreateType(orm, "Email", "nvarchar(320) not null")
+	})
+	assertDriverError(t, func(sqlserver *Sqlserver, orm contractsorm.Orm) error {
+		return sqlserver.CreateTableType(orm, "OrderLines", func(table contractsschema.Blueprint) {
+			table.String("sku", 50)
+		})
+	})
+	assertDriverError(t, func(sqlserver *Sqlserver, orm contractsorm.Orm) error {
+		return sqlserver.DropType(orm, "Email")
+	})
+}
@@ -1,18 +1,39 @@
 package sqlserver
 
 import (
+	"strings"
+
 	"github.com/goravel/framework/database/schema"
 	"github.com/goravel/framework/support/collect"
 )
 
 type Wrap struct {
 	*schema.Wrap
+	schema string
 }
 
 func NewWrap(prefix string) *Wrap {
+	return NewWrapWithSchema(prefix, "")
+}
+
+// NewWrapWithSchema Create the wrap that qualifies the tables with the default schema.
+func NewWrapWithSchema(prefix, defaultSchema string) *Wrap {
 	return &Wrap{
-		Wrap: schema.NewWrap(prefix),
+		Wrap:   schema.NewWrap(prefix),
+		schema: defaultSchema,
+	}
+}
+
+// Table Wrap the table, and qualify it with the default schema when it isn't qualified, the alias isn't qualified.
+func (r *Wrap) Table(table string) string {
+	if index := strings.Index(strings.ToLower(table), " as "); index >= 0 {
+		return r.Table(table[:index]) + " as " + r.Value(r.GetPrefix()+table[index+len(" as "):])
 	}
+	if r.schema != "" && !strings.Contains(table, ".") {
+		return r.Value(r.schema) + "." + r.Wrap.Table(table)
+	}
+
+	return r.Wrap.Table(table)
 }
 
 func (r *Wrap) Quotes(value []string) []string {
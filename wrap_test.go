@@ -23,3 +23,16 @@ func (s *WrapTestSuite) TestQuotes() {
 	result := s.wrap.Quotes([]string{"value1", "value2"})
 	s.Equal([]string{"N'value1'", "N'value2'"}, result)
 }
+
+func (s *WrapTestSuite) TestTable() {
+	s.Equal(`"prefix_users"`, s.wrap.Table("users"))
+	s.Equal(`"dbo"."prefix_users"`, s.wrap.Table("dbo.users"))
+
+	wrap := NewWrapWithSchema("prefix_", "app")
+	s.Equal(`"app"."prefix_users"`, wrap.Table("users"))
+	s.Equal(`"dbo"."prefix_users"`, wrap.Table("dbo.users"))
+	s.Equal(`"app"."prefix_users" as "prefix_u"`, wrap.Table("users as u"))
+	s.Equal(`"app"."prefix_users" as "prefix_u"`, wrap.Table("users AS u"))
+	s.Equal(`"dbo"."prefix_users" as "prefix_u"`, wrap.Table("dbo.users as u"))
+	s.Equal(`"prefix_users" as "prefix_u"`, s.wrap.Table("users as u"))
+}